@@ -25,6 +25,11 @@ func (ref *ActorProcess) SendSystemMessage(_ *PID, message interface{}) {
 	ref.mailbox.PostSystemMessage(message)
 }
 
+// UserMessageCount returns the number of user messages waiting in the mailbox
+func (ref *ActorProcess) UserMessageCount() int {
+	return ref.mailbox.UserMessageCount()
+}
+
 func (ref *ActorProcess) Stop(pid *PID) {
 	atomic.StoreInt32(&ref.dead, 1)
 	ref.SendSystemMessage(pid, stopMessage)
@@ -20,6 +20,13 @@ var ErrTimeout = errors.New("future: timeout")
 // ErrDeadLetter is meaning you request to a unreachable PID.
 var ErrDeadLetter = errors.New("future: dead letter")
 
+// FailureResponse is sent back to the sender instead of a response when a request could not be delivered.
+// A future completes with it as its error rather than as its result.
+type FailureResponse interface {
+	error
+	FailureResponse()
+}
+
 // NewFuture creates and returns a new actor.Future with a timeout of duration d.
 func NewFuture(actorSystem *ActorSystem, d time.Duration) *Future {
 	ref := &futureProcess{Future{actorSystem: actorSystem, cond: sync.NewCond(&sync.Mutex{})}}
@@ -154,10 +161,14 @@ func (ref *futureProcess) SendUserMessage(pid *PID, message interface{}) {
 
 	_, msg, _ := UnwrapEnvelope(message)
 
-	if _, ok := msg.(*DeadLetterResponse); ok {
+	switch msg := msg.(type) {
+	case *DeadLetterResponse:
 		ref.result = nil
 		ref.err = ErrDeadLetter
-	} else {
+	case FailureResponse:
+		ref.result = nil
+		ref.err = msg
+	default:
 		ref.result = msg
 	}
 
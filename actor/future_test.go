@@ -100,6 +100,22 @@ func TestFuture_Result_DeadLetterResponse(t *testing.T) {
 	a.Nil(resp)
 }
 
+type failureResponse struct{}
+
+func (failureResponse) Error() string { return "failed" }
+
+func (failureResponse) FailureResponse() {}
+
+func TestFuture_Result_FailureResponse(t *testing.T) {
+	a := assert.New(t)
+
+	future := NewFuture(system, 1*time.Second)
+	rootContext.Send(future.PID(), failureResponse{})
+	resp, err := future.Result()
+	a.Equal(failureResponse{}, err)
+	a.Nil(resp)
+}
+
 func TestFuture_Result_Timeout(t *testing.T) {
 	a := assert.New(t)
 
@@ -44,6 +44,17 @@ func NewMetrics(system *ActorSystem, provider metric.MeterProvider) *Metrics {
 	}
 }
 
+// GetMetrics returns the metrics extension of the actor system
+func GetMetrics(actorSystem *ActorSystem) *Metrics {
+	m, _ := actorSystem.Extensions.Get(extensionId).(*Metrics)
+	return m
+}
+
+// ProtoMetrics returns the instrument registry, it is nil when metrics are disabled
+func (m *Metrics) ProtoMetrics() *metrics.ProtoMetrics {
+	return m.metrics
+}
+
 func (m *Metrics) PrepareMailboxLengthGauge() {
 	meter := otel.Meter(metrics.LibName)
 	gauge, err := meter.Int64ObservableGauge("protoactor_actor_mailbox_length",
@@ -0,0 +1,159 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const broadcastActorName = "broadcast"
+
+// GrainResult is the response of a grain to RequestMany, or the error of the request
+type GrainResult struct {
+	Identity string
+	Response interface{}
+	Err      error
+}
+
+// startBroadcast spawns the actor delivering broadcasts to the grains on this member
+func (c *Cluster) startBroadcast() {
+	props := actor.PropsFromProducer(func() actor.Actor { return &broadcastActor{cluster: c} })
+	if _, err := c.ActorSystem.Root.SpawnNamed(props, broadcastActorName); err != nil {
+		panic(err) // let it crash
+	}
+}
+
+// BroadcastToKind sends the message to every grain of the kind activated on any member. It returns the number
+// of grains the message was sent to by the members which were reached, the grains do not acknowledge it,
+// and the errors of the members that could not be reached once the retries of the call options were exhausted.
+func (c *Cluster) BroadcastToKind(kind string, message proto.Message, opts ...GrainCallOption) (int, error) {
+	payload, err := anypb.New(message)
+	if err != nil {
+		return 0, fmt.Errorf("could not pack broadcast message: %w", err)
+	}
+
+	callConfig := DefaultGrainCallConfig(c)
+	for _, o := range opts {
+		o(callConfig)
+	}
+
+	broadcast := &GrainBroadcast{Kind: kind, Message: payload}
+	members := c.MemberList.Members().Members()
+
+	var wg sync.WaitGroup
+	delivered := make([]int, len(members))
+	errs := make([]error, len(members))
+	for i, member := range members {
+		if !member.HasKind(kind) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, member *Member) {
+			defer wg.Done()
+			delivered[i], errs[i] = c.broadcastToMember(member, broadcast, callConfig)
+		}(i, member)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, n := range delivered {
+		total += n
+	}
+
+	return total, errors.Join(errs...)
+}
+
+func (c *Cluster) broadcastToMember(member *Member, broadcast *GrainBroadcast, callConfig *GrainCallConfig) (int, error) {
+	pid := actor.NewPID(member.Address(), broadcastActorName)
+	attempt := func() (interface{}, error) {
+		return callConfig.Context.RequestFuture(pid, broadcast, callConfig.Timeout).Result()
+	}
+
+	res, err := retryRequest(callConfig, callConfig.deadline(time.Now()), attempt, nil)
+	if err != nil {
+		c.Logger().Warn("Broadcast failed", slog.String("member", member.Id), slog.String("kind", broadcast.Kind), slog.Any("error", err))
+		return 0, fmt.Errorf("broadcast to member %s failed: %w", member.Id, err)
+	}
+
+	response, ok := res.(*GrainBroadcastResponse)
+	if !ok {
+		return 0, fmt.Errorf("unexpected broadcast response from member %s: %T", member.Id, res)
+	}
+
+	return int(response.Delivered), nil
+}
+
+// RequestMany requests the grains of the kind with the message, with at most as many requests in flight as the
+// concurrency of the call options. Each request is retried like Request, the results are in the order of the identities.
+func (c *Cluster) RequestMany(identities []string, kind string, message interface{}, opts ...GrainCallOption) []GrainResult {
+	callConfig := DefaultGrainCallConfig(c)
+	for _, o := range opts {
+		o(callConfig)
+	}
+
+	concurrency := callConfig.Concurrency
+	if concurrency <= 0 || concurrency > len(identities) {
+		concurrency = len(identities)
+	}
+
+	results := make([]GrainResult, len(identities))
+	next := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				res, err := c.context.Request(identities[i], kind, message, opts...)
+				results[i] = GrainResult{Identity: identities[i], Response: res, Err: err}
+			}
+		}()
+	}
+	for i := range identities {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	return results
+}
+
+// broadcastActor delivers broadcasts to the grains of the kind activated on this member
+type broadcastActor struct {
+	cluster *Cluster
+}
+
+func (b *broadcastActor) Receive(ctx actor.Context) {
+	msg, ok := ctx.Message().(*GrainBroadcast)
+	if !ok {
+		return
+	}
+
+	message, err := msg.Message.UnmarshalNew()
+	if err != nil {
+		ctx.Logger().Error("Failed to unpack broadcast message", slog.String("kind", msg.Kind), slog.Any("error", err))
+		ctx.Respond(&GrainBroadcastResponse{})
+		return
+	}
+
+	var delivered int32
+	b.cluster.activations.Range(func(_, value interface{}) bool {
+		activation := value.(*Activation)
+		if activation.ClusterIdentity.Kind == msg.Kind {
+			// each grain gets its own copy, local messages are not serialized
+			ctx.Send(activation.Pid, proto.Clone(message))
+			delivered++
+		}
+		return true
+	})
+
+	ctx.Respond(&GrainBroadcastResponse{Delivered: delivered})
+}
@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"log/slog"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -16,16 +17,23 @@ import (
 var extensionID = extensions.NextExtensionID()
 
 type Cluster struct {
-	ActorSystem    *actor.ActorSystem
-	Config         *Config
-	Gossip         *Gossiper
-	PubSub         *PubSub
-	Remote         *remote.Remote
-	PidCache       *PidCacheValue
-	MemberList     *MemberList
-	IdentityLookup IdentityLookup
-	kinds          map[string]*ActivatedKind
-	context        Context
+	ActorSystem        *actor.ActorSystem
+	Config             *Config
+	Gossip             *Gossiper
+	PubSub             *PubSub
+	DistributedData    *DistributedData
+	Remote             *remote.Remote
+	PidCache           *PidCacheValue
+	MemberList         *MemberList
+	IdentityLookup     IdentityLookup
+	kinds              map[string]*ActivatedKind
+	context            Context
+	activations        sync.Map // cluster identity key -> Activation of the grains on this member
+	handedOff          sync.Map // cluster identity key -> Rebalanced of the grains this member handed off, until they fetch it
+	loads              *memberLoads
+	metrics            *clusterMetrics
+	splitBrainResolver *splitBrainResolver
+	gatewayClient      bool // started by StartGatewayClient, without membership nor gossip
 }
 
 var _ extensions.Extension = &Cluster{}
@@ -35,6 +43,7 @@ func New(actorSystem *actor.ActorSystem, config *Config) *Cluster {
 		ActorSystem: actorSystem,
 		Config:      config,
 		kinds:       map[string]*ActivatedKind{},
+		loads:       newMemberLoads(),
 	}
 	actorSystem.Extensions.Register(c)
 
@@ -42,12 +51,15 @@ func New(actorSystem *actor.ActorSystem, config *Config) *Cluster {
 	c.PidCache = NewPidCache()
 	c.MemberList = NewMemberList(c)
 	c.subscribeToTopologyEvents()
+	c.subscribeToMemberLoads()
+	c.metrics = newClusterMetrics(c)
 
 	actorSystem.Extensions.Register(c)
 
 	var err error
 	c.Gossip, err = newGossiper(c)
 	c.PubSub = NewPubSub(c)
+	c.DistributedData = NewDistributedData(c)
 
 	if err != nil {
 		panic(err)
@@ -101,8 +113,19 @@ func (c *Cluster) StartMember() {
 	if err := c.Gossip.StartGossiping(); err != nil {
 		panic(err)
 	}
+	c.Gossip.SetState(StartedKey, &MemberStarted{StartedAtUnixMilliseconds: time.Now().UnixMilli()})
+	if metadata := c.memberMetadata(); metadata != nil {
+		c.Gossip.SetState(MetadataKey, metadata)
+	}
 	c.PubSub.Start()
+	c.startHandoff()
+	c.startSingletons()
+	c.startBroadcast()
+	c.startGateway()
+	c.startSplitBrainResolver()
 	c.MemberList.InitializeTopologyConsensus()
+	c.MemberList.InitializeLifecycleConsensus()
+	c.startLifecycle()
 
 	if err := cfg.ClusterProvider.StartMember(c); err != nil {
 		panic(err)
@@ -111,15 +134,27 @@ func (c *Cluster) StartMember() {
 	time.Sleep(1 * time.Second)
 }
 
+// GetClusterKinds returns the kinds this member hosts, leaving out the kinds whose placement requires
+// labels or roles this member does not have. Singleton kinds are left out as well, they are not activated
+// by identity but run by the singleton managers.
 func (c *Cluster) GetClusterKinds() []string {
+	self := &Member{Labels: c.Config.Labels, Roles: c.Config.Roles}
 	keys := make([]string, 0, len(c.kinds))
-	for k := range c.kinds {
-		keys = append(keys, k)
+	for k, kind := range c.kinds {
+		if !kind.Singleton && kind.Placement.Allows(self) {
+			keys = append(keys, k)
+		}
 	}
 
 	return keys
 }
 
+// Placement returns the placement of the kind, nil if the kind is not constrained. The placements are gossiped,
+// a member which does not define the kind places it like the members which do.
+func (c *Cluster) Placement(kind string) *Placement {
+	return c.MemberList.placement(kind)
+}
+
 func (c *Cluster) StartClient() {
 	cfg := c.Config
 	c.Remote = remote.NewRemote(c.ActorSystem, c.Config.RemoteConfig)
@@ -139,7 +174,36 @@ func (c *Cluster) StartClient() {
 }
 
 func (c *Cluster) Shutdown(graceful bool) {
+	if c.gatewayClient {
+		c.metrics.stop()
+		c.ActorSystem.Shutdown()
+		c.Remote.Shutdown(graceful)
+		c.Logger().Info("Stopped Proto.Actor cluster gateway client", slog.String("address", c.ActorSystem.Address()))
+
+		return
+	}
+
+	c.stopSplitBrainResolver()
+	c.setLifecycle(MemberState_Leaving)
+	if graceful {
+		// the members stop placing grains on this member before it stops
+		c.awaitLifecycleAgreed()
+		c.stopSingletons()
+	}
+
+	if graceful && c.Config.HandoffTimeout > 0 {
+		// the handed off grains fetch their state from this member, it stays in the topology until they are activated
+		if handoff := c.handoff(c.Config.HandoffTimeout); handoff != nil {
+			c.awaitHandoff(handoff)
+		}
+	}
+
 	c.Gossip.SetState(GracefullyLeftKey, &emptypb.Empty{})
+
+	// the members do not have to agree on exiting, nothing is placed on a member which is not up,
+	// leaving is the state they agreed on
+	c.setLifecycle(MemberState_Exiting)
+	c.metrics.stop()
 	c.ActorSystem.Shutdown()
 	if graceful {
 		_ = c.Config.ClusterProvider.Shutdown(graceful)
@@ -204,9 +268,17 @@ func (c *Cluster) ensureTopicKindRegistered() {
 		}
 	}
 	if !hasTopicKind {
-		store := &EmptyKeyValueStore[*Subscribers]{}
+		var store KeyValueStore[*Subscribers] = &EmptyKeyValueStore[*Subscribers]{}
+		if producer := c.Config.PubSubConfig.SubscriberStore; producer != nil {
+			store = producer(c)
+		}
+
+		topicLog := c.Config.PubSubConfig.TopicLog
 
 		c.kinds[TopicActorKind] = NewKind(TopicActorKind, actor.PropsFromProducer(func() actor.Actor {
+			if topicLog != nil {
+				return NewDurableTopicActor(store, topicLog, c.Logger())
+			}
 			return NewTopicActor(store, c.Logger())
 		})).Build(c)
 	}
@@ -0,0 +1,134 @@
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// clusterMetrics records the cluster instruments, all methods are no-ops when metrics are disabled
+type clusterMetrics struct {
+	instruments  *metrics.ClusterMetrics
+	registration metric.Registration
+
+	mutex       sync.Mutex
+	subscribers map[string]int // subscriber count of the topics activated on this member
+}
+
+func newClusterMetrics(c *Cluster) *clusterMetrics {
+	m := &clusterMetrics{subscribers: map[string]int{}}
+
+	sysMetrics := actor.GetMetrics(c.ActorSystem)
+	if sysMetrics == nil || !sysMetrics.Enabled() {
+		return m
+	}
+
+	pm := sysMetrics.ProtoMetrics()
+	pm.RegisterCluster(metrics.InternalClusterMetrics, metrics.NewClusterMetrics(c.Logger()))
+	m.instruments = pm.GetCluster(metrics.InternalClusterMetrics)
+	if m.instruments == nil {
+		return m
+	}
+
+	meter := otel.Meter(metrics.LibName)
+	registration, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		address := attribute.String("address", c.ActorSystem.Address())
+		for memberID, load := range c.MemberLoads() {
+			member := attribute.String("member", memberID)
+			for kind, count := range load.Activations {
+				o.ObserveInt64(m.instruments.ClusterMemberActivationCount, count,
+					metric.WithAttributes(address, member, attribute.String("kind", kind)))
+			}
+			o.ObserveInt64(m.instruments.ClusterMemberMailboxBacklog, load.MailboxBacklog, metric.WithAttributes(address, member))
+			o.ObserveFloat64(m.instruments.ClusterMemberCPUUsage, load.CPUUsage, metric.WithAttributes(address, member))
+		}
+
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		for topic, count := range m.subscribers {
+			o.ObserveInt64(m.instruments.PubSubSubscriberCount, int64(count), metric.WithAttributes(address, attribute.String("topic", topic)))
+		}
+		return nil
+	}, m.instruments.ClusterMemberActivationCount, m.instruments.ClusterMemberMailboxBacklog, m.instruments.ClusterMemberCPUUsage,
+		m.instruments.PubSubSubscriberCount)
+	if err != nil {
+		c.Logger().Error("failed to instrument cluster member loads", slog.Any("error", err))
+	} else {
+		m.registration = registration
+	}
+
+	return m
+}
+
+func (m *clusterMetrics) stop() {
+	if m.registration != nil {
+		_ = m.registration.Unregister()
+	}
+}
+
+func (m *clusterMetrics) enabled() bool {
+	return m != nil && m.instruments != nil
+}
+
+// recordPublish records a batch published to the topic, and how long the topic took to accept it
+func (m *clusterMetrics) recordPublish(topic string, size int, duration time.Duration) {
+	if !m.enabled() {
+		return
+	}
+
+	attributes := metric.WithAttributes(attribute.String("topic", topic))
+	ctx := context.Background()
+	m.instruments.PubSubPublishHistogram.Record(ctx, duration.Seconds(), attributes)
+	m.instruments.PubSubBatchSizeHistogram.Record(ctx, int64(size), attributes)
+	m.instruments.PubSubPublishedMessageCount.Add(ctx, int64(size), attributes)
+}
+
+// recordDelivered records messages of the topic delivered to a subscriber
+func (m *clusterMetrics) recordDelivered(topic string, count int) {
+	if !m.enabled() {
+		return
+	}
+
+	m.instruments.PubSubDeliveredMessageCount.Add(context.Background(), int64(count), metric.WithAttributes(attribute.String("topic", topic)))
+}
+
+// recordDeliveryFailure records a batch of the topic that failed to deliver to a subscriber
+func (m *clusterMetrics) recordDeliveryFailure(topic string, status DeliveryStatus) {
+	if !m.enabled() {
+		return
+	}
+
+	m.instruments.PubSubDeliveryFailureCount.Add(context.Background(), 1,
+		metric.WithAttributes(attribute.String("topic", topic), attribute.String("status", status.String())))
+}
+
+// setSubscriberCount sets the subscriber count of the topic activated on this member, a negative count removes the topic
+func (m *clusterMetrics) setSubscriberCount(topic string, count int) {
+	if !m.enabled() {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if count < 0 {
+		delete(m.subscribers, topic)
+		return
+	}
+	m.subscribers[topic] = count
+}
+
+// recordProducerQueued records messages entering, or leaving with a negative count, the queue of a batching producer of the topic
+func (m *clusterMetrics) recordProducerQueued(topic string, count int) {
+	if !m.enabled() {
+		return
+	}
+
+	m.instruments.PubSubProducerQueueLength.Add(context.Background(), int64(count), metric.WithAttributes(attribute.String("topic", topic)))
+}
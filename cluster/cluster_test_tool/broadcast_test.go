@@ -0,0 +1,45 @@
+package cluster_test_tool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestBroadcastToKind_ReachesGrainsOnAllMembers(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(3,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("counter", actor.PropsFromProducer(counterGrain))}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+	identities := make([]string, 10)
+	for i := range identities {
+		identities[i] = fmt.Sprintf("counter-%d", i)
+	}
+
+	results := c.RequestMany(identities, "counter", &wrapperspb.Int64Value{Value: 1}, cluster.WithConcurrency(3))
+	require.Len(t, results, len(identities))
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		assert.Equal(t, identities[i], result.Identity)
+		assert.Equal(t, int64(1), result.Response.(*wrapperspb.Int64Value).Value)
+	}
+
+	delivered, err := c.BroadcastToKind("counter", &wrapperspb.Int64Value{Value: 5})
+	require.NoError(t, err)
+	assert.Equal(t, len(identities), delivered)
+
+	for _, result := range c.RequestMany(identities, "counter", &wrapperspb.Int64Value{Value: 0}) {
+		require.NoError(t, result.Err)
+		assert.Equal(t, int64(6), result.Response.(*wrapperspb.Int64Value).Value, result.Identity)
+	}
+}
@@ -28,6 +28,7 @@ type ClusterFixtureConfig struct {
 	Configure          func(*cluster.Config) *cluster.Config
 	GetIdentityLookup  func(clusterName string) cluster.IdentityLookup
 	OnDeposing         func()
+	ActorSystemOptions []actor.ConfigOption
 }
 
 type ClusterFixtureOption func(*ClusterFixtureConfig)
@@ -67,6 +68,13 @@ func WithOnDeposing(onDeposing func()) ClusterFixtureOption {
 	}
 }
 
+// WithActorSystemConfig sets the options of the actor systems of the cluster members
+func WithActorSystemConfig(options ...actor.ConfigOption) ClusterFixtureOption {
+	return func(c *ClusterFixtureConfig) {
+		c.ActorSystemOptions = options
+	}
+}
+
 const InvalidIdentity string = "invalid"
 
 type BaseClusterFixture struct {
@@ -114,6 +122,7 @@ func (b *BaseClusterFixture) GetClusterSize() int {
 func (b *BaseClusterFixture) SpawnNode() *cluster.Cluster {
 	node := b.spawnClusterMember()
 	b.members = append(b.members, node)
+	b.waitForMembersUp(b.members)
 	return node
 }
 
@@ -170,10 +179,34 @@ func (b *BaseClusterFixture) spawnClusterNodes() []*cluster.Cluster {
 	if err != nil {
 		panic("Failed to reach consensus")
 	}
+	b.waitForMembersUp(nodes)
 
 	return nodes
 }
 
+// waitForMembersUp waits for the members to see each other up, before grains are placed on them
+func (b *BaseClusterFixture) waitForMembersUp(nodes []*cluster.Cluster) {
+	deadline := time.Now().Add(time.Second * 10)
+	for !membersUp(nodes) {
+		if time.Now().After(deadline) {
+			panic("Members are not up")
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+}
+
+func membersUp(nodes []*cluster.Cluster) bool {
+	for _, node := range nodes {
+		for _, other := range nodes {
+			if node.MemberList.State(other.ActorSystem.ID) != cluster.MemberState_Up {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // spawnClusterMember spawns a cluster members
 func (b *BaseClusterFixture) spawnClusterMember() *cluster.Cluster {
 	config := cluster.Configure(b.clusterName, b.config.GetClusterProvider(), b.config.GetIdentityLookup(b.clusterName),
@@ -182,7 +215,7 @@ func (b *BaseClusterFixture) spawnClusterMember() *cluster.Cluster {
 	)
 	config = b.config.Configure(config)
 
-	system := actor.NewActorSystem()
+	system := actor.NewActorSystem(b.config.ActorSystemOptions...)
 
 	c := cluster.New(system, config)
 	c.StartMember()
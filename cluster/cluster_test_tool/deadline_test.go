@@ -0,0 +1,69 @@
+package cluster_test_tool
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDeadline_PropagatesToNestedRequestsAndDropsExpiredRequests(t *testing.T) {
+	var processed int32
+	// outer calls inner with its own context, inner answers with the deadline it sees
+	outer := actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(*wrapperspb.StringValue); ok {
+			c := cluster.GetCluster(ctx.ActorSystem())
+			res, err := c.Request("inner", "inner", &wrapperspb.StringValue{}, cluster.WithContext(ctx))
+			if err != nil {
+				ctx.Respond(&wrapperspb.Int64Value{})
+				return
+			}
+			ctx.Respond(res)
+		}
+	})
+	inner := actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *wrapperspb.StringValue:
+			deadline, _ := cluster.Deadline(ctx)
+			ctx.Respond(&wrapperspb.Int64Value{Value: deadline.UnixMilli()})
+		case *wrapperspb.Int64Value:
+			atomic.AddInt32(&processed, 1)
+			time.Sleep(time.Duration(msg.Value) * time.Millisecond)
+			ctx.Respond(msg)
+		}
+	})
+
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("outer", outer), cluster.NewKind("inner", inner)}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+
+	start := time.Now()
+	res, err := c.Request("outer", "outer", &wrapperspb.StringValue{}, cluster.WithTimeout(2*time.Second))
+	require.NoError(t, err)
+	deadline := time.UnixMilli(res.(*wrapperspb.Int64Value).Value)
+	assert.True(t, deadline.After(start), deadline)
+	assert.False(t, deadline.After(start.Add(2*time.Second)), deadline)
+
+	// the first request keeps the grain busy until the second one expired
+	first, err := c.RequestFuture("inner", "inner", &wrapperspb.Int64Value{Value: 500}, cluster.WithTimeout(2*time.Second))
+	require.NoError(t, err)
+	_, err = c.Request("inner", "inner", &wrapperspb.Int64Value{Value: 0}, cluster.WithTimeout(100*time.Millisecond), cluster.WithRetryCount(1))
+	require.Error(t, err)
+	_, err = first.Result()
+	require.NoError(t, err)
+
+	_, err = c.Request("inner", "inner", &wrapperspb.Int64Value{Value: 0})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&processed))
+}
@@ -0,0 +1,61 @@
+package cluster_test_tool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDistributedData_ConvergesOnAllMembers(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(3)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+
+	changes := make(chan map[string]bool, 1)
+	unsubscribe := members[2].DistributedData.Flags("features").Subscribe(func(flags map[string]bool) {
+		// the callbacks are called by the gossip, they must not block
+		select {
+		case changes <- flags:
+		default:
+		}
+	})
+	defer unsubscribe()
+
+	for i, member := range members {
+		member.DistributedData.GCounter("visits").Increment(uint64(i + 1))
+		member.DistributedData.PNCounter("stock").Increment(10)
+		member.DistributedData.PNCounter("stock").Decrement(uint64(i))
+		member.DistributedData.ORSet("members").Add(member.ActorSystem.ID)
+	}
+	members[0].DistributedData.Flags("features").Enable("dark-mode")
+	// a member only removes the additions it has seen
+	members[0].DistributedData.ORSet("members").Remove(members[0].ActorSystem.ID)
+	settings := cluster.GetLWWMap[*wrapperspb.StringValue](members[1].DistributedData, "settings")
+	require.NoError(t, settings.Set("theme", wrapperspb.String("blue")))
+
+	for _, member := range members {
+		dd := member.DistributedData
+		WaitUntil(t, func() bool {
+			theme, ok := cluster.GetLWWMap[*wrapperspb.StringValue](dd, "settings").Get("theme")
+			return dd.GCounter("visits").Value() == 6 &&
+				dd.PNCounter("stock").Value() == 27 &&
+				len(dd.ORSet("members").Elements()) == 2 &&
+				dd.Flags("features").Enabled("dark-mode") &&
+				ok && theme.Value == "blue"
+		}, "distributed data did not converge on member "+member.ActorSystem.ID, 10*time.Second)
+		assert.False(t, dd.ORSet("members").Contains(members[0].ActorSystem.ID))
+	}
+
+	select {
+	case flags := <-changes:
+		assert.True(t, flags["dark-mode"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("flags subscriber was not called")
+	}
+}
@@ -0,0 +1,169 @@
+package cluster_test_tool
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGatewayClient_RequestsGrainsThroughSeeds(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("counter", actor.PropsFromProducer(counterGrain))}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	// the first seed cannot be reached, the client fails over to the member
+	config := cluster.Configure(fixture.clusterName, nil, nil, remote.Configure("localhost", 0),
+		cluster.WithGatewaySeeds("localhost:1", members[0].ActorSystem.Address()))
+	client := cluster.New(actor.NewActorSystem(), config)
+	client.StartGatewayClient()
+	defer client.Shutdown(true)
+
+	assert.True(t, remote.IsClientAddress(client.ActorSystem.Address()))
+
+	for i := 1; i <= 3; i++ {
+		res, err := client.Request("a", "counter", &wrapperspb.Int64Value{Value: 1}, cluster.WithTimeout(5*time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), res.(*wrapperspb.Int64Value).Value)
+	}
+
+	// the grain is the one the members see
+	res, err := members[1].Request("a", "counter", &wrapperspb.Int64Value{Value: 1}, cluster.WithTimeout(5*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), res.(*wrapperspb.Int64Value).Value)
+
+	res, err = client.Request("a", "unknown", &wrapperspb.Int64Value{Value: 1}, cluster.WithTimeout(time.Second))
+	require.NoError(t, err)
+	assert.IsType(t, &cluster.GrainErrorResponse{}, res)
+}
+
+func TestGateway_BoundsRequestsInFlight(t *testing.T) {
+	var running, maxRunning int32
+	slow := actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*wrapperspb.Int64Value); ok {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			ctx.Respond(msg)
+		}
+	})
+
+	fixture := NewBaseInMemoryClusterFixture(1,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("slow", slow)}
+		}),
+		WithClusterConfigure(func(config *cluster.Config) *cluster.Config {
+			config.GatewayConcurrency = 1
+			return config
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	config := cluster.Configure(fixture.clusterName, nil, nil, remote.Configure("localhost", 0),
+		cluster.WithGatewaySeeds(fixture.GetMembers()[0].ActorSystem.Address()))
+	client := cluster.New(actor.NewActorSystem(), config)
+	client.StartGatewayClient()
+	defer client.Shutdown(true)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := client.Request(fmt.Sprintf("slow-%d", i), "slow", &wrapperspb.Int64Value{Value: int64(i)}, cluster.WithTimeout(5*time.Second))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(i), res.(*wrapperspb.Int64Value).Value)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+}
+
+func TestGateway_RefusesRequestsBeyondTheQueueAndDropsExpiredOnes(t *testing.T) {
+	release := make(chan struct{})
+	var received sync.Map
+	blocking := actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*wrapperspb.Int64Value); ok {
+			received.Store(msg.Value, true)
+			if msg.Value == 0 {
+				<-release
+			}
+			ctx.Respond(msg)
+		}
+	})
+
+	fixture := NewBaseInMemoryClusterFixture(1,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("blocking", blocking)}
+		}),
+		WithClusterConfigure(func(config *cluster.Config) *cluster.Config {
+			cluster.WithGatewayConcurrency(1)(config)
+			cluster.WithGatewayQueueSize(1)(config)
+			return config
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	config := cluster.Configure(fixture.clusterName, nil, nil, remote.Configure("localhost", 0),
+		cluster.WithGatewaySeeds(fixture.GetMembers()[0].ActorSystem.Address()))
+	client := cluster.New(actor.NewActorSystem(), config)
+	client.StartGatewayClient()
+	defer client.Shutdown(true)
+
+	request := func(i int64, timeout time.Duration) (interface{}, error) {
+		return client.Request(fmt.Sprintf("blocking-%d", i), "blocking", &wrapperspb.Int64Value{Value: i},
+			cluster.WithTimeout(timeout), cluster.WithRetryCount(1))
+	}
+
+	// the first request blocks the gateway, the second waits in turn and expires, the third finds the queue full
+	first := make(chan error, 1)
+	go func() {
+		_, err := request(0, 5*time.Second)
+		first <- err
+	}()
+	WaitUntil(t, func() bool { _, ok := received.Load(int64(0)); return ok }, "first request was not forwarded", 5*time.Second)
+
+	expired := make(chan error, 1)
+	go func() {
+		_, err := request(1, 300*time.Millisecond)
+		expired <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	res, err := request(2, 5*time.Second)
+	require.NoError(t, err)
+	require.IsType(t, &cluster.GrainErrorResponse{}, res)
+	assert.Equal(t, cluster.ErrorReason_RESOURCE_EXHAUSTED, res.(*cluster.GrainErrorResponse).Reason)
+
+	require.Error(t, <-expired)
+	close(release)
+	require.NoError(t, <-first)
+
+	res, err = request(3, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), res.(*wrapperspb.Int64Value).Value)
+	_, forwarded := received.Load(int64(1))
+	assert.False(t, forwarded, "the expired request was forwarded")
+}
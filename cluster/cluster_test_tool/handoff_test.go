@@ -0,0 +1,202 @@
+package cluster_test_tool
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// counterGrain adds the received values and hands its total over when its member leaves
+func counterGrain() actor.Actor {
+	total := int64(0)
+	return actor.ReceiveFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *wrapperspb.Int64Value:
+			total += msg.Value
+			ctx.Respond(&wrapperspb.Int64Value{Value: total})
+		case *cluster.Rebalancing:
+			ctx.Respond(&wrapperspb.Int64Value{Value: total})
+		case *cluster.Rebalanced:
+			var state wrapperspb.Int64Value
+			if msg.State != nil && msg.State.UnmarshalTo(&state) == nil {
+				total = state.Value
+			}
+		}
+	})
+}
+
+func TestHandoff_GrainStateSurvivesGracefulLeave(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("counter", actor.PropsFromProducer(counterGrain))}
+		}),
+		WithClusterConfigure(func(c *cluster.Config) *cluster.Config {
+			cluster.WithHandoffTimeout(5 * time.Second)(c)
+			return c
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	leaving, staying := members[0], members[1]
+
+	identities := make([]string, 0)
+	for i := 0; i < 10; i++ {
+		identity := fmt.Sprintf("counter-%d", i)
+		for j := 0; j < 3; j++ {
+			_, err := staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 1})
+			require.NoError(t, err)
+		}
+		if pid := staying.Get(identity, "counter"); pid != nil && pid.Address == leaving.ActorSystem.Address() {
+			identities = append(identities, identity)
+		}
+	}
+	require.NotEmpty(t, identities, "some grains live on the leaving member")
+
+	fixture.RemoveNode(leaving, true)
+
+	for _, identity := range identities {
+		res, err := staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 0})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), res.(*wrapperspb.Int64Value).Value, identity)
+
+		pid := staying.Get(identity, "counter")
+		require.NotNil(t, pid)
+		assert.Equal(t, staying.ActorSystem.Address(), pid.Address)
+	}
+}
+
+func TestHandoff_GrainActivatedByARequestGetsItsState(t *testing.T) {
+	var leavingAddress atomic.Value
+	var slowIdentity atomic.Value
+	stopped := make(chan string, 100)
+	props := actor.PropsFromProducer(func() actor.Actor {
+		counter := counterGrain()
+		return actor.ReceiveFunc(func(ctx actor.Context) {
+			identity := cluster.GetClusterIdentity(ctx)
+			switch ctx.Message().(type) {
+			case *actor.Stopping:
+				// the grains stopped first wait for it before the handoff activates them
+				if identity.Identity == slowIdentity.Load() {
+					time.Sleep(time.Second)
+				}
+			case *actor.Stopped:
+				if ctx.Self().Address == leavingAddress.Load() {
+					stopped <- identity.Identity
+				}
+			}
+			counter.Receive(ctx)
+		})
+	})
+
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("counter", props)}
+		}),
+		WithClusterConfigure(func(c *cluster.Config) *cluster.Config {
+			cluster.WithHandoffTimeout(5 * time.Second)(c)
+			return c
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	leaving, staying := members[0], members[1]
+	leavingAddress.Store(leaving.ActorSystem.Address())
+
+	identities := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		identity := fmt.Sprintf("counter-%d", i)
+		for j := 0; j < 3; j++ {
+			_, err := staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 1})
+			require.NoError(t, err)
+		}
+		if pid := staying.Get(identity, "counter"); pid != nil && pid.Address == leaving.ActorSystem.Address() {
+			identities = append(identities, identity)
+		}
+	}
+	require.GreaterOrEqual(t, len(identities), 2, "some grains live on the leaving member")
+	slowIdentity.Store(identities[0])
+
+	removed := make(chan struct{})
+	go func() {
+		fixture.RemoveNode(leaving, true)
+		close(removed)
+	}()
+
+	// a grain stopped on the leaving member is activated by a request before the handoff activates it
+	var identity string
+	for identity == "" || identity == identities[0] {
+		select {
+		case identity = <-stopped:
+		case <-time.After(5 * time.Second):
+			t.Fatal("grains were not stopped")
+		}
+	}
+	res, err := staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), res.(*wrapperspb.Int64Value).Value, identity)
+
+	<-removed
+	res, err = staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 0})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), res.(*wrapperspb.Int64Value).Value, "the handoff did not overwrite the state of %s", identity)
+}
+
+func TestHandoff_GrainIgnoringRebalancingDoesNotDelayTheOthers(t *testing.T) {
+	kinds := func() []*cluster.Kind {
+		return []*cluster.Kind{
+			cluster.NewKind("counter", actor.PropsFromProducer(counterGrain)),
+			cluster.NewKind("stateless", actor.PropsFromFunc(func(ctx actor.Context) {
+				if _, ok := ctx.Message().(*wrapperspb.Int64Value); ok {
+					ctx.Respond(ctx.Message())
+				}
+			})),
+		}
+	}
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithGetClusterKinds(kinds),
+		WithClusterConfigure(func(c *cluster.Config) *cluster.Config {
+			cluster.WithHandoffTimeout(3 * time.Second)(c)
+			cluster.WithHandoffStateTimeout(200 * time.Millisecond)(c)
+			return c
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	leaving, staying := members[0], members[1]
+
+	identities := make([]string, 0)
+	for i := 0; i < 10; i++ {
+		identity := fmt.Sprintf("grain-%d", i)
+		_, err := staying.Request(identity, "stateless", &wrapperspb.Int64Value{Value: 1})
+		require.NoError(t, err)
+		for j := 0; j < 3; j++ {
+			_, err := staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 1})
+			require.NoError(t, err)
+		}
+		if pid := staying.Get(identity, "counter"); pid != nil && pid.Address == leaving.ActorSystem.Address() {
+			identities = append(identities, identity)
+		}
+	}
+	require.NotEmpty(t, identities, "some grains live on the leaving member")
+
+	fixture.RemoveNode(leaving, true)
+
+	for _, identity := range identities {
+		res, err := staying.Request(identity, "counter", &wrapperspb.Int64Value{Value: 0})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), res.(*wrapperspb.Int64Value).Value, identity)
+	}
+}
@@ -0,0 +1,61 @@
+package cluster_test_tool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestIdempotency_RepeatedKeyGetsCachedResponse(t *testing.T) {
+	// flaky answers that it is unavailable every other request
+	flaky := func() actor.Actor {
+		calls := 0
+		return actor.ReceiveFunc(func(ctx actor.Context) {
+			if _, ok := ctx.Message().(*wrapperspb.StringValue); ok {
+				calls++
+				if calls%2 == 1 {
+					ctx.Respond(cluster.NewGrainErrorResponse(cluster.ErrorReason_UNAVAILABLE, "try again"))
+					return
+				}
+				ctx.Respond(&wrapperspb.Int64Value{Value: int64(calls)})
+			}
+		})
+	}
+
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{
+				cluster.NewKind("dedup", actor.PropsFromProducer(counterGrain, cluster.WithDedupWindow(time.Minute))),
+				cluster.NewKind("flaky", actor.PropsFromProducer(flaky, cluster.WithDedupWindow(time.Minute))),
+			}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+
+	request := func(key string) int64 {
+		res, err := c.Request("counter", "dedup", &wrapperspb.Int64Value{Value: 1}, cluster.WithIdempotencyKey(key))
+		require.NoError(t, err)
+		return res.(*wrapperspb.Int64Value).Value
+	}
+	assert.Equal(t, int64(1), request("a"))
+	assert.Equal(t, int64(1), request("a"))
+	assert.Equal(t, int64(2), request("b"))
+
+	// the transient error response is retried and not remembered for the key
+	res, err := c.Request("flaky", "flaky", &wrapperspb.StringValue{}, cluster.WithIdempotencyKey("c"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), res.(*wrapperspb.Int64Value).Value)
+
+	// errors that are not transient are returned as they are
+	res, err = c.Request("flaky", "flaky", &wrapperspb.StringValue{}, cluster.WithRetryPolicy(func(error) bool { return false }))
+	require.NoError(t, err)
+	assert.Equal(t, cluster.ErrorReason_UNAVAILABLE, res.(*cluster.GrainErrorResponse).Reason)
+}
@@ -0,0 +1,65 @@
+package cluster_test_tool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestGossipKeyValueStore_SharesValuesBetweenMembers(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(2)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	ctx := context.Background()
+	members := fixture.GetMembers()
+	first := cluster.NewGossipKeyValueStore[*cluster.Subscribers](members[0], "test/", 100*time.Millisecond)
+	second := cluster.NewGossipKeyValueStore[*cluster.Subscribers](members[1], "test/", 100*time.Millisecond)
+
+	subscribers := func(id string) *cluster.Subscribers {
+		return &cluster.Subscribers{Subscribers: []*cluster.SubscriberIdentity{
+			{Identity: &cluster.SubscriberIdentity_Pid{Pid: actor.NewPID("localhost:0", id)}},
+		}}
+	}
+	subscriberID := func(store cluster.KeyValueStore[*cluster.Subscribers]) string {
+		value, err := store.Get(ctx, "topic")
+		require.NoError(t, err)
+		if value == nil {
+			return ""
+		}
+		return value.Subscribers[0].GetPid().Id
+	}
+
+	require.NoError(t, first.Set(ctx, "topic", subscribers("a")))
+	WaitUntil(t, func() bool { return subscriberID(second) == "a" }, "value was not gossiped", 5*time.Second)
+
+	// the value written last wins, whichever member wrote it
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, second.Set(ctx, "topic", subscribers("b")))
+	WaitUntil(t, func() bool { return subscriberID(first) == "b" }, "latest value was not gossiped", 5*time.Second)
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, first.Clear(ctx, "topic"))
+	WaitUntil(t, func() bool { return subscriberID(second) == "" }, "cleared value was not gossiped", 5*time.Second)
+
+	// the tombstone goes away after the retention, the superseded value of the second member went before it
+	WaitUntil(t, func() bool {
+		if subscriberID(first) != "" || subscriberID(second) != "" {
+			return false
+		}
+		state, err := members[1].Gossip.GetState("test/")
+		require.NoError(t, err)
+		for _, kv := range state {
+			entries := &cluster.GossipStoreEntries{}
+			require.NoError(t, kv.Value.UnmarshalTo(entries))
+			if len(entries.Entries) > 0 {
+				return false
+			}
+		}
+		return true
+	}, "tombstone was not purged", 5*time.Second)
+}
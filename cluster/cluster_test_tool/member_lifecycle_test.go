@@ -0,0 +1,46 @@
+package cluster_test_tool
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberLifecycle_MembersSeeJoiningAndLeavingMembers(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(2)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	member := fixture.GetMembers()[0]
+	var mutex sync.Mutex
+	states := map[string][]string{}
+	member.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch e := evt.(type) {
+		case *cluster.MemberUp:
+			states[e.Member.Id] = append(states[e.Member.Id], "up")
+		case *cluster.MemberLeaving:
+			states[e.Member.Id] = append(states[e.Member.Id], "leaving")
+		case *cluster.MemberDown:
+			states[e.Member.Id] = append(states[e.Member.Id], "down")
+		}
+	})
+
+	joined := fixture.SpawnNode()
+	id := joined.ActorSystem.ID
+	assert.Equal(t, cluster.MemberState_Up, joined.MemberList.State(id))
+	assert.Len(t, member.MemberList.Placeable(member.MemberList.Members().Members()), 3)
+
+	fixture.RemoveNode(joined, true)
+	WaitUntil(t, func() bool {
+		return member.MemberList.State(id) == cluster.MemberState_Down
+	}, "member did not go down", 10*time.Second)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []string{"up", "leaving", "down"}, states[id])
+}
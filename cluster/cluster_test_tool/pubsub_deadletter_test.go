@@ -0,0 +1,82 @@
+package cluster_test_tool
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterTopic_ReceivesMessagesSubscriberFailedToProcess(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(1,
+		WithClusterConfigure(func(config *cluster.Config) *cluster.Config {
+			cluster.WithPubSubSubscriberTimeout(300 * time.Millisecond)(config)
+			cluster.WithPubSubDeliveryAttempts(2)(config)
+			cluster.WithPubSubDeadLetterTopics()(config)
+			return config
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+
+	// the subscriber takes longer than the subscriber timeout to process the poison message
+	var poisonAttempts atomic.Int32
+	poisoned, err := c.ActorSystem.Root.SpawnNamed(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*DataPublished); ok && msg.Data == 13 {
+			poisonAttempts.Add(1)
+			time.Sleep(400 * time.Millisecond)
+		}
+	}), "poisoned")
+	require.NoError(t, err)
+	_, err = c.SubscribeByPid("jobs", poisoned)
+	require.NoError(t, err)
+
+	var mutex sync.Mutex
+	var deadLetters []*cluster.PubSubDeadLetter
+	collector, err := c.ActorSystem.Root.SpawnNamed(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*cluster.PubSubDeadLetter); ok {
+			mutex.Lock()
+			deadLetters = append(deadLetters, msg)
+			mutex.Unlock()
+		}
+	}), "dead-letters")
+	require.NoError(t, err)
+	_, err = c.SubscribeByPid(cluster.DeadLetterTopic("jobs"), collector)
+	require.NoError(t, err)
+
+	_, err = c.Publisher().Publish(context.Background(), "jobs", &DataPublished{Data: 13})
+	require.NoError(t, err)
+
+	received := func() []*cluster.PubSubDeadLetter {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]*cluster.PubSubDeadLetter(nil), deadLetters...)
+	}
+	WaitUntil(t, func() bool { return len(received()) == 1 }, "dead-letter topic did not receive the message", 5*time.Second)
+
+	deadLetter := received()[0]
+	assert.Equal(t, "jobs", deadLetter.Topic)
+	assert.Equal(t, int32(2), deadLetter.Attempts)
+	WaitUntil(t, func() bool { return poisonAttempts.Load() == 2 }, "subscriber did not get both attempts", 5*time.Second)
+	assert.Equal(t, cluster.DeliveryStatus_Timeout, deadLetter.Status)
+	assert.Equal(t, poisoned.Id, deadLetter.Subscriber.GetPid().GetId())
+	assert.NotEmpty(t, deadLetter.Reason)
+
+	message, err := deadLetter.Message(c.Remote.Serializers())
+	require.NoError(t, err)
+	assert.Equal(t, int32(13), message.(*DataPublished).Data)
+}
+
+func TestDeadLetterTopic_IsNotMatchedByPatternsOfOtherTopics(t *testing.T) {
+	assert.True(t, cluster.MatchTopic("$deadletter.>", cluster.DeadLetterTopic("jobs")))
+	assert.True(t, cluster.IsDeadLetterTopic(cluster.DeadLetterTopic("jobs")))
+	assert.False(t, cluster.IsDeadLetterTopic("jobs"))
+}
@@ -0,0 +1,137 @@
+package cluster_test_tool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dataCollector collects the data published to the topics it subscribed to
+type dataCollector struct {
+	mutex sync.Mutex
+	data  []int32
+}
+
+func (d *dataCollector) props() *actor.Props {
+	return actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*DataPublished); ok {
+			d.mutex.Lock()
+			d.data = append(d.data, msg.Data)
+			d.mutex.Unlock()
+		}
+	})
+}
+
+func (d *dataCollector) received() []int32 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return append([]int32(nil), d.data...)
+}
+
+func TestDurableTopic_ReplaysRetainedBatchesToSubscribers(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(1,
+		WithClusterConfigure(func(config *cluster.Config) *cluster.Config {
+			cluster.WithPubSubTopicLog(cluster.NewInMemoryTopicLog(0, 0))(config)
+			return config
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+	publish := func(data int32) {
+		_, err := c.Publisher().Publish(context.Background(), "durable", &DataPublished{Data: data})
+		require.NoError(t, err)
+	}
+
+	publish(1)
+	publish(2)
+
+	// a new subscriber gets the batches published before it subscribed
+	collector := &dataCollector{}
+	pid, err := c.ActorSystem.Root.SpawnNamed(collector.props(), "collector")
+	require.NoError(t, err)
+	_, err = c.SubscribeByPidFrom("durable", pid, cluster.StartFromBeginning())
+	require.NoError(t, err)
+	publish(3)
+	WaitUntil(t, func() bool { return len(collector.received()) == 3 }, "subscriber did not get the retained batches", 5*time.Second)
+	assert.Equal(t, []int32{1, 2, 3}, collector.received())
+
+	// the subscriber is down while 4 is published, and resumes after 3 when it comes back
+	require.NoError(t, c.ActorSystem.Root.StopFuture(pid).Wait())
+	publish(4)
+	time.Sleep(200 * time.Millisecond)
+
+	comeback := &dataCollector{}
+	pid, err = c.ActorSystem.Root.SpawnNamed(comeback.props(), "collector")
+	require.NoError(t, err)
+	_, err = c.SubscribeByPid("durable", pid)
+	require.NoError(t, err)
+	publish(5)
+	WaitUntil(t, func() bool { return len(comeback.received()) == 2 }, "subscriber did not resume", 5*time.Second)
+	assert.Equal(t, []int32{4, 5}, comeback.received())
+
+	// a subscriber asking for nothing in particular gets the next batches only
+	latest := &dataCollector{}
+	_, err = c.SubscribeByPid("durable", c.ActorSystem.Root.Spawn(latest.props()))
+	require.NoError(t, err)
+	publish(6)
+	WaitUntil(t, func() bool { return len(latest.received()) == 1 }, "subscriber did not get the next batch", 5*time.Second)
+	assert.Equal(t, []int32{6}, latest.received())
+}
+
+func TestDurableTopic_AdvancesTheOffsetOfAGroupSpreadOverMembers(t *testing.T) {
+	log := cluster.NewInMemoryTopicLog(0, 0)
+	fixture := NewBaseInMemoryClusterFixture(2,
+		WithClusterConfigure(func(config *cluster.Config) *cluster.Config {
+			cluster.WithPubSubTopicLog(log)(config)
+			return config
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	// the group takes turns among its members, the slow one acknowledges its batches after the next ones
+	fast, slow := &dataCollector{}, &dataCollector{}
+	slowProps := actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*DataPublished); ok {
+			time.Sleep(50 * time.Millisecond)
+			slow.mutex.Lock()
+			slow.data = append(slow.data, msg.Data)
+			slow.mutex.Unlock()
+		}
+	})
+	_, err := members[0].SubscribeByPidToGroup("spread", "workers", members[0].ActorSystem.Root.Spawn(fast.props()))
+	require.NoError(t, err)
+	_, err = members[1].SubscribeByPidToGroup("spread", "workers", members[1].ActorSystem.Root.Spawn(slowProps))
+	require.NoError(t, err)
+
+	for i := int32(1); i <= 10; i++ {
+		_, err := members[0].Publisher().Publish(context.Background(), "spread", &DataPublished{Data: i})
+		require.NoError(t, err)
+	}
+	WaitUntil(t, func() bool { return len(fast.received())+len(slow.received()) == 10 }, "batches were not delivered", 5*time.Second)
+	assert.NotEmpty(t, fast.received())
+	assert.NotEmpty(t, slow.received())
+
+	_, last, err := log.Bounds(context.Background(), "spread")
+	require.NoError(t, err)
+	WaitUntil(t, func() bool {
+		offsets, err := log.LoadOffsets(context.Background(), "spread")
+		require.NoError(t, err)
+		for _, offset := range offsets {
+			if offset != last {
+				return false
+			}
+		}
+		return len(offsets) == 1
+	}, "the offset of the group did not reach the last batch", 5*time.Second)
+}
@@ -0,0 +1,60 @@
+package cluster_test_tool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumerGroup_DeliversEachBatchToOneSubscriberOfTheGroup(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(1)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+	publish := func(data int32) {
+		_, err := c.Publisher().Publish(context.Background(), "work", &DataPublished{Data: data})
+		require.NoError(t, err)
+	}
+
+	workers := []*dataCollector{{}, {}, {}}
+	pids := make([]*actor.PID, len(workers))
+	for i, worker := range workers {
+		pids[i] = c.ActorSystem.Root.Spawn(worker.props())
+		_, err := c.SubscribeByPidToGroup("work", "workers", pids[i])
+		require.NoError(t, err)
+	}
+	everything := &dataCollector{}
+	_, err := c.SubscribeByPid("work", c.ActorSystem.Root.Spawn(everything.props()))
+	require.NoError(t, err)
+
+	groupReceived := func() []int32 {
+		var received []int32
+		for _, worker := range workers {
+			received = append(received, worker.received()...)
+		}
+		return received
+	}
+
+	for i := int32(1); i <= 9; i++ {
+		publish(i)
+	}
+	WaitUntil(t, func() bool { return len(everything.received()) == 9 && len(groupReceived()) == 9 }, "batches were not delivered", 5*time.Second)
+	assert.ElementsMatch(t, everything.received(), groupReceived())
+	for _, worker := range workers {
+		assert.Len(t, worker.received(), 3)
+	}
+
+	// the batches of the stopped worker go to the other workers
+	require.NoError(t, c.ActorSystem.Root.StopFuture(pids[0]).Wait())
+	for i := int32(10); i <= 15; i++ {
+		publish(i)
+	}
+	WaitUntil(t, func() bool { return len(groupReceived()) == 15 }, "batches were not redelivered", 5*time.Second)
+	assert.ElementsMatch(t, everything.received(), groupReceived())
+	assert.Len(t, workers[0].received(), 3)
+}
@@ -0,0 +1,105 @@
+package cluster_test_tool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPubSubMetrics_RecordsPublishingAndDelivery(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	otel.SetMeterProvider(provider)
+
+	fixture := NewBaseInMemoryClusterFixture(1, WithActorSystemConfig(actor.WithMetricProviders(provider)))
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+	collector := &dataCollector{}
+	pid := c.ActorSystem.Root.Spawn(collector.props())
+	_, err := c.SubscribeByPid("metered", pid)
+	require.NoError(t, err)
+
+	_, err = c.Publisher().Publish(context.Background(), "metered", &DataPublished{Data: 1})
+	require.NoError(t, err)
+	producer := c.BatchingProducer("metered")
+	info, err := producer.Produce(context.Background(), &DataPublished{Data: 2})
+	require.NoError(t, err)
+	<-info.Finished
+	producer.Dispose()
+	WaitUntil(t, func() bool { return len(collector.received()) == 2 }, "subscriber did not get the messages", 5*time.Second)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	names := map[string]bool{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names[m.Name] = true
+		}
+	}
+
+	assert.True(t, names["protoactor_cluster_pubsub_publish_duration_seconds"])
+	assert.True(t, names["protoactor_cluster_pubsub_batch_size"])
+	assert.True(t, names["protoactor_cluster_pubsub_published_message_count"])
+	assert.True(t, names["protoactor_cluster_pubsub_delivered_message_count"])
+	assert.True(t, names["protoactor_cluster_pubsub_subscriber_count"])
+	assert.True(t, names["protoactor_cluster_pubsub_producer_queue_length"])
+}
+
+func TestPubSubTracing_PropagatesTraceContextToSubscribers(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	fixture := NewBaseInMemoryClusterFixture(1)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+	topic := cluster.NewTypedTopic[*DataPublished](c, "traced")
+
+	handled := make(chan trace.SpanContext, 1)
+	_, err := topic.SubscribeWithContext(func(ctx context.Context, _ *DataPublished) error {
+		handled <- trace.SpanContextFromContext(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "request")
+	_, err = topic.Publish(ctx, &DataPublished{Data: 1})
+	require.NoError(t, err)
+	span.End()
+
+	select {
+	case spanContext := <-handled:
+		// the handler continues the trace of the request that published the message
+		assert.Equal(t, span.SpanContext().TraceID(), spanContext.TraceID())
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscriber did not get the message")
+	}
+
+	spans := map[string]bool{}
+	WaitUntil(t, func() bool {
+		for _, s := range exporter.GetSpans() {
+			spans[s.Name] = s.SpanContext.TraceID() == span.SpanContext().TraceID()
+		}
+		return spans["traced process"]
+	}, "subscriber span was not exported", 5*time.Second)
+	assert.True(t, spans["traced publish"])
+	assert.True(t, spans["traced process"])
+}
@@ -0,0 +1,69 @@
+package cluster_test_tool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedTopic_AcknowledgesBatchesTheHandlerProcessed(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(1,
+		WithClusterConfigure(func(config *cluster.Config) *cluster.Config {
+			cluster.WithPubSubDeliveryAttempts(2)(config)
+			return config
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	c := fixture.GetMembers()[0]
+	topic := cluster.NewTypedTopic[*DataPublished](c, "typed")
+
+	var mutex sync.Mutex
+	var received []int32
+	failed := false
+	subscription, err := topic.Subscribe(func(msg *DataPublished) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if msg.Data == 2 && !failed {
+			// the first delivery of 2 fails, the delivery is attempted again
+			failed = true
+			return errors.New("not yet")
+		}
+		received = append(received, msg.Data)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = topic.Publish(context.Background(), &DataPublished{Data: 1})
+	require.NoError(t, err)
+	_, err = topic.PublishBatch(context.Background(), []*DataPublished{{Data: 2}, {Data: 3}})
+	require.NoError(t, err)
+
+	// messages of other types published to the topic do not reach the typed subscriber
+	_, err = c.Publisher().Publish(context.Background(), "typed", &cluster.PubSubPatterns{Patterns: []string{"other"}})
+	require.NoError(t, err)
+
+	producer := topic.BatchingProducer()
+	info, err := producer.Produce(context.Background(), &DataPublished{Data: 4})
+	require.NoError(t, err)
+	<-info.Finished
+	require.NoError(t, info.Err)
+	producer.Dispose()
+
+	snapshot := func() []int32 {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]int32(nil), received...)
+	}
+	WaitUntil(t, func() bool { return len(snapshot()) == 4 }, "typed subscriber did not process all messages", 5*time.Second)
+	assert.Equal(t, []int32{1, 2, 3, 4}, snapshot())
+
+	require.NoError(t, subscription.Unsubscribe())
+}
@@ -0,0 +1,52 @@
+package cluster_test_tool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWildcardTopics_DeliverMatchingTopicsThroughFilters(t *testing.T) {
+	fixture := NewBaseInMemoryClusterFixture(2)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+
+	everything := &dataCollector{}
+	_, err := members[0].SubscribeByPid("orders.>", members[0].ActorSystem.Root.Spawn(everything.props()))
+	require.NoError(t, err)
+
+	europe := &dataCollector{}
+	_, err = members[0].Subscribe("orders.*", &cluster.SubscribeRequest{
+		Subscriber: &cluster.SubscriberIdentity{Identity: &cluster.SubscriberIdentity_Pid{Pid: members[0].ActorSystem.Root.Spawn(europe.props())}},
+		Filter:     &cluster.SubscriptionFilter{Headers: map[string]string{"region": "eu"}},
+	})
+	require.NoError(t, err)
+
+	// the patterns with subscribers are gossiped to the members hosting the topics
+	time.Sleep(2 * time.Second)
+
+	publish := func(topic string, data int32, region string) {
+		_, err := members[1].Publisher().PublishBatch(context.Background(), topic, &cluster.PubSubBatch{
+			Envelopes: []proto.Message{&DataPublished{Data: data}},
+			Headers:   []map[string]string{{"region": region}},
+		})
+		require.NoError(t, err)
+	}
+	publish("orders.paris", 1, "eu")
+	publish("orders.ohio", 2, "us")
+	publish("orders.eu.berlin", 3, "eu")
+	publish("invoices.paris", 4, "eu")
+
+	WaitUntil(t, func() bool { return len(everything.received()) == 3 }, "pattern subscriber did not get the matching topics", 5*time.Second)
+	assert.ElementsMatch(t, []int32{1, 2, 3}, everything.received())
+	WaitUntil(t, func() bool { return len(europe.received()) == 1 }, "filtered subscriber did not get the matching message", 5*time.Second)
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, []int32{1}, europe.received())
+}
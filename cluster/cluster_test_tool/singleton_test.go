@@ -0,0 +1,85 @@
+package cluster_test_tool
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSingleton_RunsOnceAndMovesWhenItsMemberLeaves(t *testing.T) {
+	var started int32
+	singleton := func() actor.Actor {
+		return actor.ReceiveFunc(func(ctx actor.Context) {
+			switch ctx.Message().(type) {
+			case *actor.Started:
+				atomic.AddInt32(&started, 1)
+			case *wrapperspb.StringValue:
+				ctx.Respond(&wrapperspb.StringValue{Value: ctx.Self().Address + "|" + ctx.MessageHeader().Get("trace")})
+			}
+		})
+	}
+
+	fixture := NewBaseInMemoryClusterFixture(3,
+		WithGetClusterKinds(func() []*cluster.Kind {
+			kind := cluster.NewKind("scheduler", actor.PropsFromProducer(singleton))
+			kind.WithSingleton()
+			return []*cluster.Kind{kind}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	proxy := cluster.NewSingletonProxy(members[0], "scheduler")
+
+	// the first message is buffered until the singleton is located, with its header
+	system := members[0].ActorSystem
+	future := actor.NewFuture(system, 10*time.Second)
+	system.Root.Send(proxy.PID(), &actor.MessageEnvelope{
+		Header:  map[string]string{"trace": "abc"},
+		Message: &wrapperspb.StringValue{},
+		Sender:  future.PID(),
+	})
+	res, err := future.Result()
+	require.NoError(t, err)
+	host, trace, _ := strings.Cut(res.(*wrapperspb.StringValue).Value, "|")
+	assert.Equal(t, "abc", trace)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&started))
+
+	// a singleton kind is not activated by identity
+	_, err = members[0].Request("other", "scheduler", &wrapperspb.StringValue{},
+		cluster.WithTimeout(time.Second), cluster.WithRetryCount(1))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&started))
+
+	var hosting, other *cluster.Cluster
+	for _, member := range members {
+		if member.ActorSystem.Address() == host {
+			hosting = member
+		} else {
+			other = member
+		}
+	}
+	require.NotNil(t, hosting)
+	proxy = cluster.NewSingletonProxy(other, "scheduler")
+
+	for i := 0; i < 5; i++ {
+		res, err := proxy.Request(&wrapperspb.StringValue{}, 10*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, host+"|", res.(*wrapperspb.StringValue).Value)
+	}
+
+	fixture.RemoveNode(hosting, true)
+
+	res, err = proxy.Request(&wrapperspb.StringValue{}, 10*time.Second)
+	require.NoError(t, err)
+	assert.NotEqual(t, host+"|", res.(*wrapperspb.StringValue).Value)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&started))
+}
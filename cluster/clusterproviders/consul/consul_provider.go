@@ -3,6 +3,7 @@ package consul
 import (
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +15,11 @@ import (
 
 var ProviderShuttingDownError = fmt.Errorf("consul cluster provider is shutting down")
 
+const (
+	metaLabelPrefix = "label-"
+	metaRoles       = "roles"
+)
+
 type Provider struct {
 	cluster            *cluster.Cluster
 	deregistered       bool
@@ -140,9 +146,7 @@ func (p *Provider) registerService() error {
 		Tags:    p.knownKinds,
 		Address: p.address,
 		Port:    p.port,
-		Meta: map[string]string{
-			"id": p.id,
-		},
+		Meta:    serviceMeta(p.id, p.cluster.Config.Labels, p.cluster.Config.Roles),
 		Check: &api.AgentServiceCheck{
 			DeregisterCriticalServiceAfter: p.deregisterCritical.String(),
 			TTL:                            p.ttl.String(),
@@ -181,11 +185,14 @@ func (p *Provider) notifyStatuses() {
 				memberId = fmt.Sprintf("%v@%v:%v", p.clusterName, v.Service.Address, v.Service.Port)
 				p.cluster.Logger().Info("meta['id'] was empty, fixeds", slog.String("id", memberId))
 			}
+			labels, roles := memberMetadata(v.Service.Meta)
 			members = append(members, &cluster.Member{
-				Id:    memberId,
-				Host:  v.Service.Address,
-				Port:  int32(v.Service.Port),
-				Kinds: v.Service.Tags,
+				Id:     memberId,
+				Host:   v.Service.Address,
+				Port:   int32(v.Service.Port),
+				Kinds:  v.Service.Tags,
+				Labels: labels,
+				Roles:  roles,
 			})
 		}
 	}
@@ -204,3 +211,39 @@ func (p *Provider) monitorMemberStatusChanges() {
 		}
 	}()
 }
+
+// serviceMeta publishes the id, labels and roles of the member in the service meta,
+// label keys must be valid consul meta keys
+func serviceMeta(id string, labels map[string]string, roles []string) map[string]string {
+	meta := map[string]string{
+		"id": id,
+	}
+	for key, value := range labels {
+		meta[metaLabelPrefix+key] = value
+	}
+	if len(roles) > 0 {
+		meta[metaRoles] = strings.Join(roles, ",")
+	}
+
+	return meta
+}
+
+// memberMetadata reads the labels and roles of a member from its service meta
+func memberMetadata(meta map[string]string) (map[string]string, []string) {
+	var labels map[string]string
+	for key, value := range meta {
+		if strings.HasPrefix(key, metaLabelPrefix) {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[strings.TrimPrefix(key, metaLabelPrefix)] = value
+		}
+	}
+
+	var roles []string
+	if value := meta[metaRoles]; value != "" {
+		roles = strings.Split(value, ",")
+	}
+
+	return labels, roles
+}
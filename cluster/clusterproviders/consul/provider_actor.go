@@ -114,11 +114,14 @@ func (pa *providerActor) processConsulUpdate(index uint64, result interface{}, c
 				memberId = fmt.Sprintf("%v@%v:%v", pa.clusterName, v.Service.Address, v.Service.Port)
 				ctx.Logger().Info("meta['id'] was empty, fixed", slog.String("id", memberId))
 			}
+			labels, roles := memberMetadata(v.Service.Meta)
 			members = append(members, &cluster.Member{
-				Id:    memberId,
-				Host:  v.Service.Address,
-				Port:  int32(v.Service.Port),
-				Kinds: v.Service.Tags,
+				Id:     memberId,
+				Host:   v.Service.Address,
+				Port:   int32(v.Service.Port),
+				Kinds:  v.Service.Tags,
+				Labels: labels,
+				Roles:  roles,
 			})
 		}
 	}
@@ -71,6 +71,7 @@ func (p *Provider) init(c *cluster.Cluster) error {
 	nodeName := fmt.Sprintf("%v@%v", p.clusterName, memberID)
 	p.self = NewNode(nodeName, host, port, knownKinds)
 	p.self.SetMeta("id", p.getID())
+	p.self.Labels, p.self.Roles = c.Config.Labels, c.Config.Roles
 	return nil
 }
 
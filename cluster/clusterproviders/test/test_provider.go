@@ -79,7 +79,10 @@ func (t *Provider) StartMember(c *cluster.Cluster) error {
 	t.id = c.ActorSystem.ID
 	t.startTtlReport()
 	t.agent.SubscribeStatusUpdate(t.notifyStatuses)
-	t.agent.RegisterService(NewAgentServiceStatus(t.id, host, port, kinds))
+	status := NewAgentServiceStatus(t.id, host, port, kinds)
+	status.Labels = c.Config.Labels
+	status.Roles = c.Config.Roles
+	t.agent.RegisterService(status)
 	return nil
 }
 
@@ -111,10 +114,12 @@ func (t *Provider) notifyStatuses() {
 		copiedKinds = append(copiedKinds, status.Kinds...)
 
 		members = append(members, &cluster.Member{
-			Id:    status.ID,
-			Port:  int32(status.Port),
-			Host:  status.Host,
-			Kinds: copiedKinds,
+			Id:     status.ID,
+			Port:   int32(status.Port),
+			Host:   status.Host,
+			Kinds:  copiedKinds,
+			Labels: maps.Clone(status.Labels),
+			Roles:  append([]string(nil), status.Roles...),
 		})
 	}
 	t.memberList.UpdateClusterTopology(members)
@@ -203,11 +208,13 @@ func (m *InMemAgent) onStatusUpdate() {
 }
 
 type AgentServiceStatus struct {
-	ID    string
-	TTL   time.Time // last alive time
-	Host  string
-	Port  int
-	Kinds []string
+	ID     string
+	TTL    time.Time // last alive time
+	Host   string
+	Port   int
+	Kinds  []string
+	Labels map[string]string
+	Roles  []string
 }
 
 // NewAgentServiceStatus creates a new AgentServiceStatus.
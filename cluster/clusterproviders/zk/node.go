@@ -19,6 +19,8 @@ type Node struct {
 	Address string            `json:"address"`
 	Port    int               `json:"port"`
 	Kinds   []string          `json:"kinds"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Roles   []string          `json:"roles,omitempty"`
 	Meta    map[string]string `json:"-"`
 	Alive   bool              `json:"alive"`
 }
@@ -82,10 +84,12 @@ func (n *Node) MemberStatus() *cluster.Member {
 		kinds = []string{}
 	}
 	return &cluster.Member{
-		Id:    n.ID,
-		Host:  host,
-		Port:  int32(port),
-		Kinds: kinds,
+		Id:     n.ID,
+		Host:   host,
+		Port:   int32(port),
+		Kinds:  kinds,
+		Labels: n.Labels,
+		Roles:  n.Roles,
 	}
 }
 
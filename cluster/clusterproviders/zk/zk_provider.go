@@ -102,6 +102,7 @@ func (p *Provider) init(c *cluster.Cluster) error {
 	nodeName := fmt.Sprintf("%v@%v:%v", p.clusterName, host, port)
 	p.self = NewNode(nodeName, host, port, knownKinds)
 	p.self.SetMeta(metaKeyID, p.getID())
+	p.self.Labels, p.self.Roles = c.Config.Labels, c.Config.Roles
 
 	if err = p.createClusterNode(p.clusterKey); err != nil {
 		return err
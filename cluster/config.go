@@ -28,6 +28,15 @@ type Config struct {
 	GossipMaxSend                                int
 	HeartbeatExpiration                          time.Duration // Gossip heartbeat timeout. If the member does not update its heartbeat within this period, it will be added to the BlockList
 	PubSubConfig                                 *PubSubConfig
+	HandoffTimeout                               time.Duration      // Maximum duration of the grain handoff on graceful shutdown, 0 disables the handoff
+	HandoffStateTimeout                          time.Duration      // Maximum duration a grain takes to reply to Rebalancing, and a new activation to fetch the state
+	Labels                                       map[string]string  // Labels of this member, published through the cluster provider and gossip
+	Roles                                        []string           // Roles of this member, published through the cluster provider and gossip
+	GatewaySeeds                                 []string           // Addresses of the members a gateway client sends its requests through
+	GatewayConcurrency                           int                // Requests the gateway of this member forwards at most at a time, the others wait in turn
+	GatewayQueueSize                             int                // Requests waiting in turn at most at the gateway of this member, the others are refused
+	SplitBrainStrategy                           SplitBrainStrategy // Decides which side of a network partition keeps running, nil disables the split brain resolver
+	SplitBrainStableAfter                        time.Duration      // Time the topology must not change before the split brain resolver decides
 }
 
 func Configure(clusterName string, clusterProvider ClusterProvider, identityLookup IdentityLookup, remoteConfig *remote.Config, options ...ConfigOption) *Config {
@@ -49,6 +58,9 @@ func Configure(clusterName string, clusterProvider ClusterProvider, identityLook
 		GossipMaxSend:        50,
 		HeartbeatExpiration:  time.Second * 20,
 		PubSubConfig:         newPubSubConfig(),
+		GatewayConcurrency:   1000,
+		GatewayQueueSize:     10000,
+		HandoffStateTimeout:  time.Second,
 	}
 
 	for _, option := range options {
@@ -95,8 +107,10 @@ func withClusterReceiveMiddleware() actor.PropsOption {
 				handleStarted(c, next, envelope)
 			case *actor.Stopped:
 				handleStopped(c, next, envelope)
+			case *Rebalanced:
+				handleRebalanced(c, next, envelope)
 			default:
-				next(c, envelope)
+				handleDeadline(c, next, envelope)
 			}
 
 			return
@@ -105,27 +119,43 @@ func withClusterReceiveMiddleware() actor.PropsOption {
 }
 
 func handleStopped(c actor.ReceiverContext, next actor.ReceiverFunc, envelope *actor.MessageEnvelope) {
-	/*
-	   clusterKind.Dec();
-	*/
 	cl := GetCluster(c.ActorSystem())
 	identity := GetClusterIdentity(c)
 
 	if identity != nil {
+		if clusterKind, ok := cl.TryGetClusterKind(identity.Kind); ok {
+			clusterKind.Dev()
+		}
 		cl.ActorSystem.EventStream.Publish(&ActivationTerminating{
 			Pid:             c.Self(),
 			ClusterIdentity: identity,
 		})
 		cl.PidCache.RemoveByValue(identity.Identity, identity.Kind, c.Self())
+		if v, ok := cl.activations.Load(identity.AsKey()); ok && v.(*Activation).Pid.Equal(c.Self()) {
+			cl.activations.Delete(identity.AsKey())
+		}
 	}
 
 	next(c, envelope)
 }
 
+// handleRebalanced acknowledges the activation of a handed off grain, the grain received its state when it started
+func handleRebalanced(c actor.ReceiverContext, _ actor.ReceiverFunc, envelope *actor.MessageEnvelope) {
+	if envelope.Sender != nil {
+		c.ActorSystem().Root.Send(envelope.Sender, &RebalanceCompleted{})
+	}
+}
+
 func handleStarted(c actor.ReceiverContext, next actor.ReceiverFunc, envelope *actor.MessageEnvelope) {
 	next(c, envelope)
 	cl := GetCluster(c.ActorSystem())
 	identity := GetClusterIdentity(c)
+	if identity != nil {
+		if clusterKind, ok := cl.TryGetClusterKind(identity.Kind); ok {
+			clusterKind.Inc()
+		}
+		cl.activations.Store(identity.AsKey(), &Activation{Pid: c.Self(), ClusterIdentity: identity})
+	}
 
 	grainInit := &ClusterInit{
 		Identity: identity,
@@ -134,4 +164,11 @@ func handleStarted(c actor.ReceiverContext, next actor.ReceiverFunc, envelope *a
 
 	ge := actor.WrapEnvelope(grainInit)
 	next(c, ge)
+
+	// a grain handed off by a leaving member receives its state before any other message
+	if identity != nil {
+		if grain := cl.handedOffGrain(identity); grain != nil {
+			next(c, actor.WrapEnvelope(grain))
+		}
+	}
 }
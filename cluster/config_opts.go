@@ -48,9 +48,117 @@ func WithPubSubSubscriberTimeout(timeout time.Duration) ConfigOption {
 	}
 }
 
+// WithPubSubSubscriberStore persists the subscribers of the topics in the store, such as an
+// InMemoryKeyValueStore or a FileKeyValueStore.
+func WithPubSubSubscriberStore(store KeyValueStore[*Subscribers]) ConfigOption {
+	return func(c *Config) {
+		c.PubSubConfig.SubscriberStore = func(_ *Cluster) KeyValueStore[*Subscribers] { return store }
+	}
+}
+
+// WithPubSubGossipSubscriberStore persists the subscribers of the topics in the gossip state of the cluster,
+// so that they survive the topics moving between members without any external storage.
+func WithPubSubGossipSubscriberStore() ConfigOption {
+	return func(c *Config) {
+		c.PubSubConfig.SubscriberStore = func(cluster *Cluster) KeyValueStore[*Subscribers] {
+			return NewGossipKeyValueStore[*Subscribers](cluster, subscriberStorePrefix, subscriberStoreTombstoneRetention)
+		}
+	}
+}
+
+// WithPubSubTopicLog makes the topics durable, appending the published batches to the log and replaying them
+// to the subscribers from the offset they acknowledged last.
+func WithPubSubTopicLog(log TopicLog) ConfigOption {
+	return func(c *Config) {
+		c.PubSubConfig.TopicLog = log
+	}
+}
+
+// WithPubSubDeliveryAttempts sets the number of times a batch is delivered to a subscriber that times out
+// or fails to process it.
+func WithPubSubDeliveryAttempts(attempts int) ConfigOption {
+	return func(c *Config) {
+		c.PubSubConfig.DeliveryAttempts = attempts
+	}
+}
+
+// WithPubSubDeadLetterTopics publishes the messages the subscribers of a topic fail to process to the
+// dead-letter topic of the topic.
+func WithPubSubDeadLetterTopics() ConfigOption {
+	return func(c *Config) {
+		c.PubSubConfig.DeadLetterTopics = true
+	}
+}
+
 // WithHeartbeatExpiration sets the gossip heartbeat expiration.
 func WithHeartbeatExpiration(t time.Duration) ConfigOption {
 	return func(c *Config) {
 		c.HeartbeatExpiration = t
 	}
 }
+
+// WithHandoffTimeout enables the grain handoff on graceful shutdown. The leaving member sends Rebalancing to
+// each of its grains, stops them and has a remaining member activate them on their new owners, giving up after the timeout.
+// The grains receive their state with Rebalanced when they start, whether the handoff or a request activates them.
+// Default is 0, which disables the handoff.
+func WithHandoffTimeout(timeout time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.HandoffTimeout = timeout
+	}
+}
+
+// WithHandoffStateTimeout sets how long the leaving member waits for the grains to reply to Rebalancing,
+// grains which do not reply hand off no state. A grain starting while a member is leaving waits as long for its state.
+// Default is 1 second.
+func WithHandoffStateTimeout(timeout time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.HandoffStateTimeout = timeout
+	}
+}
+
+// WithLabels sets the labels of this member, kinds may require or prefer them in their placement.
+func WithLabels(labels map[string]string) ConfigOption {
+	return func(c *Config) {
+		c.Labels = labels
+	}
+}
+
+// WithRoles sets the roles of this member, kinds may require or prefer them in their placement.
+func WithRoles(roles ...string) ConfigOption {
+	return func(c *Config) {
+		c.Roles = roles
+	}
+}
+
+// WithGatewaySeeds sets the addresses of the members a gateway client sends its grain requests through.
+func WithGatewaySeeds(addresses ...string) ConfigOption {
+	return func(c *Config) {
+		c.GatewaySeeds = addresses
+	}
+}
+
+// WithGatewayConcurrency bounds the number of requests of gateway clients the gateway of this member forwards
+// at a time, the other requests wait in turn. Default is 1000, 0 leaves it unbounded.
+func WithGatewayConcurrency(concurrency int) ConfigOption {
+	return func(c *Config) {
+		c.GatewayConcurrency = concurrency
+	}
+}
+
+// WithGatewayQueueSize bounds the number of requests of gateway clients waiting in turn at the gateway of this member,
+// the gateway refuses the other requests with RESOURCE_EXHAUSTED. Default is 10000, 0 leaves it unbounded.
+func WithGatewayQueueSize(size int) ConfigOption {
+	return func(c *Config) {
+		c.GatewayQueueSize = size
+	}
+}
+
+// WithSplitBrainResolver enables the split brain resolver. Once members are lost without leaving gracefully and
+// the topology did not change for stableAfter, the strategy decides whether the side of this member keeps running,
+// the members of the other side stop. Default stableAfter is 20 seconds.
+func WithSplitBrainResolver(strategy SplitBrainStrategy, stableAfter time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.SplitBrainStrategy = strategy
+		c.SplitBrainStableAfter = stableAfter
+	}
+}
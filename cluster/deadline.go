@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+)
+
+// DeadlineHeader carries the deadline of a grain request, in unix milliseconds, so that the grain
+// drops the request once it expired and the grains it calls in turn share the remaining time.
+// Remote sends the time remaining rather than the deadline, the member receiving the request rebases it on its clock.
+const DeadlineHeader = remote.DeadlineHeader
+
+// messageHeaderPart is implemented by the actor and root contexts
+type messageHeaderPart interface {
+	MessageHeader() actor.ReadonlyMessageHeader
+}
+
+// Deadline returns the deadline of the request the context is processing, if the request has one
+func Deadline(ctx messageHeaderPart) (time.Time, bool) {
+	return headerDeadline(ctx.MessageHeader())
+}
+
+func headerDeadline(header actor.ReadonlyMessageHeader) (time.Time, bool) {
+	if header == nil {
+		return time.Time{}, false
+	}
+
+	value := header.Get(DeadlineHeader)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.UnixMilli(ms), true
+}
+
+// WithDeadline fails the call once the deadline is reached, whatever the timeout
+func WithDeadline(deadline time.Time) GrainCallOption {
+	return func(config *GrainCallConfig) {
+		config.Deadline = deadline
+	}
+}
+
+// deadline returns the earliest of the timeout, the deadline of the call and the deadline of the request
+// the calling context is processing, so that nested calls never outlive the call they serve
+func (config *GrainCallConfig) deadline(start time.Time) time.Time {
+	deadline := start.Add(config.Timeout)
+	if !config.Deadline.IsZero() && config.Deadline.Before(deadline) {
+		deadline = config.Deadline
+	}
+	if parent, ok := headerDeadline(config.Context.MessageHeader()); ok && parent.Before(deadline) {
+		deadline = parent
+	}
+
+	return deadline
+}
+
+// sendGrainRequest sends the request to the grain with the deadline, the idempotency key and the extra headers in its header
+func sendGrainRequest(callConfig *GrainCallConfig, pid *actor.PID, message interface{}, deadline time.Time, headers map[string]string) *actor.Future {
+	ctx := callConfig.Context
+	// an expired deadline times the future out right away, a negative timeout would never time out
+	timeout := time.Until(deadline)
+	if timeout < 0 {
+		timeout = 0
+	}
+
+	future := actor.NewFuture(ctx.ActorSystem(), timeout)
+	envelope := &actor.MessageEnvelope{
+		Message: message,
+		Sender:  future.PID(),
+	}
+	envelope.SetHeader(DeadlineHeader, strconv.FormatInt(deadline.UnixMilli(), 10))
+	if callConfig.IdempotencyKey != "" {
+		envelope.SetHeader(IdempotencyKeyHeader, callConfig.IdempotencyKey)
+	}
+	for key, value := range headers {
+		envelope.SetHeader(key, value)
+	}
+	ctx.Send(pid, envelope)
+
+	return future
+}
+
+// handleDeadline drops the requests whose deadline expired before the grain got to them
+func handleDeadline(c actor.ReceiverContext, next actor.ReceiverFunc, envelope *actor.MessageEnvelope) {
+	if deadline, ok := headerDeadline(envelope.Header); ok && time.Now().After(deadline) {
+		c.Logger().Debug("Dropping expired grain request", slog.Any("self", c.Self()),
+			slog.String("message", fmt.Sprintf("%T", envelope.Message)), slog.Time("deadline", deadline))
+		return
+	}
+
+	next(c, envelope)
+}
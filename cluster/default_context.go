@@ -4,12 +4,12 @@ package cluster
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
-	"github.com/asynkron/protoactor-go/remote"
 )
 
 // Defines a type to provide DefaultContext configurations / implementations.
@@ -33,18 +33,11 @@ func newDefaultClusterContext(cluster *Cluster) Context {
 }
 
 func (dcc *DefaultContext) Request(identity, kind string, message interface{}, opts ...GrainCallOption) (interface{}, error) {
-	var err error
-
-	var resp interface{}
-
-	var counter int
 	callConfig := DefaultGrainCallConfig(dcc.cluster)
 	for _, o := range opts {
 		o(callConfig)
 	}
 
-	_context := callConfig.Context
-
 	// get the configuration from the composed Cluster value
 	cfg := dcc.cluster.Config.ToClusterContextConfig(dcc.cluster.Logger())
 
@@ -52,58 +45,37 @@ func (dcc *DefaultContext) Request(identity, kind string, message interface{}, o
 
 	dcc.cluster.Logger().Debug(fmt.Sprintf("Requesting %s:%s Message %#v", identity, kind, message))
 
-	// crate a new Timeout Context
-	ttl := callConfig.Timeout
+	// the request ends with the request the calling context is processing
+	deadline := callConfig.deadline(start)
 
-	ctx, cancel := context.WithTimeout(context.Background(), ttl)
-	defer cancel()
-
-selectloop:
-	for {
-		select {
-		case <-ctx.Done():
-			// TODO: handler throttling and messaging here
-			err = fmt.Errorf("request failed: %w", ctx.Err())
-
-			break selectloop
-		default:
-			if counter >= callConfig.RetryCount {
-				err = fmt.Errorf("have reached max retries: %v", callConfig.RetryCount)
-
-				break selectloop
-			}
-			pid := dcc.getPid(identity, kind)
-			if pid == nil {
-				dcc.cluster.Logger().Debug("Requesting PID from IdentityLookup but got nil", slog.String("identity", identity), slog.String("kind", kind))
-				counter = callConfig.RetryAction(counter)
-				continue
-			}
+	attempt := func() (interface{}, error) {
+		pid := dcc.getPid(identity, kind)
+		if pid == nil {
+			dcc.cluster.Logger().Debug("Requesting PID from IdentityLookup but got nil", slog.String("identity", identity), slog.String("kind", kind))
+			return nil, errNotSent
+		}
 
-			// TODO: why is err != nil when res != nil?
-			resp, err = _context.RequestFuture(pid, message, ttl).Result()
-			if resp != nil {
-				break selectloop
-			}
-			if err != nil {
-				dcc.cluster.Logger().Error("cluster.RequestFuture failed", slog.Any("error", err), slog.Any("pid", pid))
-				switch err {
-				case actor.ErrTimeout, remote.ErrTimeout, actor.ErrDeadLetter, remote.ErrDeadLetter:
-					counter = callConfig.RetryAction(counter)
-					dcc.cluster.PidCache.Remove(identity, kind)
-					continue
-				default:
-					break selectloop
-				}
-			}
+		// TODO: why is err != nil when res != nil?
+		resp, err := sendGrainRequest(callConfig, pid, message, deadline, nil).Result()
+		if resp == nil && err != nil {
+			dcc.cluster.Logger().Error("cluster.RequestFuture failed", slog.Any("error", err), slog.Any("pid", pid))
+		}
 
-			// TODO: add metrics to increment retries
+		return resp, err
+	}
+	onRetry := func(err error) {
+		if !errors.Is(err, errNotSent) {
+			dcc.cluster.PidCache.Remove(identity, kind)
 		}
+		// TODO: add metrics to increment retries
 	}
 
+	resp, err := retryRequest(callConfig, deadline, attempt, onRetry)
+
 	totalTime := time.Since(start)
 	// TODO: add metrics ot set histogram for total request time
 
-	if contextError := ctx.Err(); contextError != nil && cfg.requestLogThrottle() == actor.Open {
+	if errors.Is(err, context.DeadlineExceeded) && cfg.requestLogThrottle() == actor.Open {
 		// context timeout exceeded, report and return
 		dcc.cluster.Logger().Warn("Request retried but failed", slog.String("identity", identity), slog.String("kind", kind), slog.Duration("duration", totalTime))
 	}
@@ -118,14 +90,12 @@ func (dcc *DefaultContext) RequestFuture(identity string, kind string, message i
 		o(callConfig)
 	}
 
-	_context := callConfig.Context
-
 	dcc.cluster.Logger().Debug(fmt.Sprintf("Requesting future %s:%s Message %#v", identity, kind, message))
 
-	// crate a new Timeout Context
-	ttl := callConfig.Timeout
+	// crate a new Timeout Context, which ends with the request the calling context is processing
+	deadline := callConfig.deadline(time.Now())
 
-	ctx, cancel := context.WithTimeout(context.Background(), ttl)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
 	defer cancel()
 
 	for {
@@ -135,6 +105,9 @@ func (dcc *DefaultContext) RequestFuture(identity string, kind string, message i
 			err := fmt.Errorf("request failed: %w", ctx.Err())
 			return nil, err
 		default:
+			if !time.Now().Before(deadline) {
+				return nil, fmt.Errorf("request failed: %w", context.DeadlineExceeded)
+			}
 			if counter >= callConfig.RetryCount {
 				return nil, fmt.Errorf("have reached max retries: %v", callConfig.RetryCount)
 			}
@@ -146,7 +119,7 @@ func (dcc *DefaultContext) RequestFuture(identity string, kind string, message i
 				continue
 			}
 
-			f := _context.RequestFuture(pid, message, ttl)
+			f := sendGrainRequest(callConfig, pid, message, deadline, nil)
 			return f, nil
 		}
 	}
@@ -158,7 +131,9 @@ func (dcc *DefaultContext) getPid(identity, kind string) *actor.PID {
 	pid, _ := dcc.cluster.PidCache.Get(identity, kind)
 	if pid == nil {
 		pid = dcc.cluster.Get(identity, kind)
-		dcc.cluster.PidCache.Set(identity, kind, pid)
+		if pid != nil {
+			dcc.cluster.PidCache.Set(identity, kind, pid)
+		}
 	}
 
 	return pid
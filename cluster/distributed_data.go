@@ -0,0 +1,173 @@
+package cluster
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// distributedDataPrefix prefixes the gossip keys of the replicated data types
+const distributedDataPrefix = "crdt/"
+
+// DistributedData replicates conflict-free data types, such as counters, sets, maps and flags, to all the members of
+// the cluster over gossip, without any external storage. Each member updates its replica locally and gossips its state,
+// the states gossiped by the other members are merged into it. The replicas converge once the updates are gossiped.
+// The data is lost when the whole cluster stops.
+type DistributedData struct {
+	cluster *Cluster
+
+	mutex    sync.Mutex
+	replicas map[string]replicated // by gossip key
+}
+
+// replicated is a replica of a data type, that merges the states gossiped by the other members
+type replicated interface {
+	receive(value *anypb.Any)
+}
+
+// NewDistributedData creates the DistributedData of the cluster, it merges the states gossiped for its replicas
+func NewDistributedData(cluster *Cluster) *DistributedData {
+	dd := &DistributedData{
+		cluster:  cluster,
+		replicas: map[string]replicated{},
+	}
+	cluster.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		if update, ok := evt.(*GossipUpdate); ok && strings.HasPrefix(update.Key, distributedDataPrefix) {
+			dd.mutex.Lock()
+			r, ok := dd.replicas[update.Key]
+			dd.mutex.Unlock()
+			if ok {
+				r.receive(update.Value)
+			}
+		}
+	})
+
+	return dd
+}
+
+// replica keeps the state of a data type on this member, and the callbacks to call when it changes
+type replica[S proto.Message] struct {
+	cluster *Cluster
+	key     string
+	merge   func(into S, from S) bool // merges the state from into the state into, and reports whether it changed
+
+	mutex     sync.Mutex
+	state     S
+	callbacks map[int]func()
+	nextID    int
+}
+
+// getReplica returns the replica of the key, created with the state gossiped by the members when it does not exist yet
+func getReplica[S proto.Message](dd *DistributedData, key string, merge func(into S, from S) bool) *replica[S] {
+	dd.mutex.Lock()
+	if r, ok := dd.replicas[key]; ok {
+		dd.mutex.Unlock()
+		typed, ok := r.(*replica[S])
+		if !ok {
+			panic(fmt.Errorf("distributed data %s is not a %T", key, typed))
+		}
+		return typed
+	}
+
+	var zero S
+	r := &replica[S]{
+		cluster:   dd.cluster,
+		key:       key,
+		merge:     merge,
+		state:     zero.ProtoReflect().New().Interface().(S),
+		callbacks: map[int]func(){},
+	}
+	dd.replicas[key] = r
+	dd.mutex.Unlock()
+
+	// the gossip is not available before the member starts, the replica starts empty then.
+	// The gossip actor publishes the updates it merges, so the state is not requested while holding the lock.
+	if dd.cluster.Gossip.pid != nil {
+		if state, err := dd.cluster.Gossip.GetState(key); err == nil {
+			for _, kv := range state {
+				r.mergeValue(kv.Value)
+			}
+		}
+	}
+
+	return r
+}
+
+// read calls read with the state of the replica, it must not keep the state
+func (r *replica[S]) read(read func(state S)) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	read(r.state)
+}
+
+// update applies the local update to the state of the replica, and gossips the state
+func (r *replica[S]) update(update func(state S)) {
+	r.mutex.Lock()
+	update(r.state)
+	state := proto.Clone(r.state)
+	r.mutex.Unlock()
+
+	r.cluster.Gossip.SetState(r.key, state)
+	r.changed()
+}
+
+func (r *replica[S]) receive(value *anypb.Any) {
+	if r.mergeValue(value) {
+		// the state of this member carries the merged updates, they survive the members that made them
+		r.mutex.Lock()
+		state := proto.Clone(r.state)
+		r.mutex.Unlock()
+
+		r.cluster.Gossip.SetState(r.key, state)
+		r.changed()
+	}
+}
+
+// mergeValue merges the gossiped state into the state of the replica, and reports whether it changed
+func (r *replica[S]) mergeValue(value *anypb.Any) bool {
+	var zero S
+	remote := zero.ProtoReflect().New().Interface().(S)
+	if err := value.UnmarshalTo(remote); err != nil {
+		r.cluster.Logger().Warn("could not unpack distributed data state", slog.String("key", r.key), slog.Any("error", err))
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.merge(r.state, remote)
+}
+
+// subscribe registers the callback called when the state changes, and returns the function unregistering it
+func (r *replica[S]) subscribe(callback func()) func() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	id := r.nextID
+	r.nextID++
+	r.callbacks[id] = callback
+
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		delete(r.callbacks, id)
+	}
+}
+
+func (r *replica[S]) changed() {
+	r.mutex.Lock()
+	callbacks := make([]func(), 0, len(r.callbacks))
+	for _, callback := range r.callbacks {
+		callbacks = append(callbacks, callback)
+	}
+	r.mutex.Unlock()
+
+	for _, callback := range callbacks {
+		callback()
+	}
+}
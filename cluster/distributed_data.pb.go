@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.3
+// source: distributed_data.proto
+
+package cluster
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GCounterState is the state of a grow-only counter, the count of the increments of each member
+type GCounterState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts map[string]uint64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GCounterState) Reset() {
+	*x = GCounterState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_distributed_data_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCounterState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCounterState) ProtoMessage() {}
+
+func (x *GCounterState) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_data_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCounterState.ProtoReflect.Descriptor instead.
+func (*GCounterState) Descriptor() ([]byte, []int) {
+	return file_distributed_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GCounterState) GetCounts() map[string]uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// PNCounterState is the state of a counter that can be incremented and decremented
+type PNCounterState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Increments *GCounterState `protobuf:"bytes,1,opt,name=increments,proto3" json:"increments,omitempty"`
+	Decrements *GCounterState `protobuf:"bytes,2,opt,name=decrements,proto3" json:"decrements,omitempty"`
+}
+
+func (x *PNCounterState) Reset() {
+	*x = PNCounterState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_distributed_data_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PNCounterState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PNCounterState) ProtoMessage() {}
+
+func (x *PNCounterState) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_data_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PNCounterState.ProtoReflect.Descriptor instead.
+func (*PNCounterState) Descriptor() ([]byte, []int) {
+	return file_distributed_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PNCounterState) GetIncrements() *GCounterState {
+	if x != nil {
+		return x.Increments
+	}
+	return nil
+}
+
+func (x *PNCounterState) GetDecrements() *GCounterState {
+	if x != nil {
+		return x.Decrements
+	}
+	return nil
+}
+
+// ORSetTags are the tags of the additions of an element to an ORSet, a tag identifies one addition on one member
+type ORSetTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ORSetTags) Reset() {
+	*x = ORSetTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_distributed_data_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ORSetTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ORSetTags) ProtoMessage() {}
+
+func (x *ORSetTags) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_data_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ORSetTags.ProtoReflect.Descriptor instead.
+func (*ORSetTags) Descriptor() ([]byte, []int) {
+	return file_distributed_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ORSetTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// ORSetState is the state of an observed-remove set, an element is in the set while it has added tags that were not removed
+type ORSetState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added   map[string]*ORSetTags `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Removed map[string]*ORSetTags `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// number of additions of each member, the tags of the additions of a member are unique
+	Clock map[string]uint64 `protobuf:"bytes,3,rep,name=clock,proto3" json:"clock,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ORSetState) Reset() {
+	*x = ORSetState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_distributed_data_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ORSetState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ORSetState) ProtoMessage() {}
+
+func (x *ORSetState) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_data_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ORSetState.ProtoReflect.Descriptor instead.
+func (*ORSetState) Descriptor() ([]byte, []int) {
+	return file_distributed_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ORSetState) GetAdded() map[string]*ORSetTags {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ORSetState) GetRemoved() map[string]*ORSetTags {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ORSetState) GetClock() map[string]uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+// LWWRegister is a value of an LWWMap, the value written last wins
+type LWWRegister struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value                    *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` //empty when the key was deleted
+	TimestampUnixNanoseconds int64      `protobuf:"varint,2,opt,name=timestamp_unix_nanoseconds,json=timestampUnixNanoseconds,proto3" json:"timestamp_unix_nanoseconds,omitempty"`
+	MemberId                 string     `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"` //breaks ties between writes with the same timestamp
+}
+
+func (x *LWWRegister) Reset() {
+	*x = LWWRegister{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_distributed_data_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LWWRegister) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LWWRegister) ProtoMessage() {}
+
+func (x *LWWRegister) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_data_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LWWRegister.ProtoReflect.Descriptor instead.
+func (*LWWRegister) Descriptor() ([]byte, []int) {
+	return file_distributed_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LWWRegister) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *LWWRegister) GetTimestampUnixNanoseconds() int64 {
+	if x != nil {
+		return x.TimestampUnixNanoseconds
+	}
+	return 0
+}
+
+func (x *LWWRegister) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+// LWWMapState is the state of a last-writer-wins map
+type LWWMapState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries map[string]*LWWRegister `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *LWWMapState) Reset() {
+	*x = LWWMapState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_distributed_data_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LWWMapState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LWWMapState) ProtoMessage() {}
+
+func (x *LWWMapState) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_data_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LWWMapState.ProtoReflect.Descriptor instead.
+func (*LWWMapState) Descriptor() ([]byte, []int) {
+	return file_distributed_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LWWMapState) GetEntries() map[string]*LWWRegister {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_distributed_data_proto protoreflect.FileDescriptor
+
+var file_distributed_data_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x01, 0x0a,
+	0x0d, 0x47, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x50, 0x4e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x36, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x65,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x09, 0x4f, 0x52, 0x53, 0x65,
+	0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x8c, 0x03, 0x0a, 0x0a, 0x4f, 0x52,
+	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4f, 0x52, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x64, 0x64,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x52, 0x53, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4f, 0x52, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x1a, 0x4c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x52, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e,
+	0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x52, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38,
+	0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x4c, 0x57, 0x57,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x9c, 0x01, 0x0a, 0x0b, 0x4c, 0x57, 0x57, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x3b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x57, 0x57, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x50, 0x0a, 0x0c,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x57, 0x57, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x2c,
+	0x5a, 0x2a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73,
+	0x79, 0x6e, 0x6b, 0x72, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_distributed_data_proto_rawDescOnce sync.Once
+	file_distributed_data_proto_rawDescData = file_distributed_data_proto_rawDesc
+)
+
+func file_distributed_data_proto_rawDescGZIP() []byte {
+	file_distributed_data_proto_rawDescOnce.Do(func() {
+		file_distributed_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_distributed_data_proto_rawDescData)
+	})
+	return file_distributed_data_proto_rawDescData
+}
+
+var file_distributed_data_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_distributed_data_proto_goTypes = []interface{}{
+	(*GCounterState)(nil),  // 0: cluster.GCounterState
+	(*PNCounterState)(nil), // 1: cluster.PNCounterState
+	(*ORSetTags)(nil),      // 2: cluster.ORSetTags
+	(*ORSetState)(nil),     // 3: cluster.ORSetState
+	(*LWWRegister)(nil),    // 4: cluster.LWWRegister
+	(*LWWMapState)(nil),    // 5: cluster.LWWMapState
+	nil,                    // 6: cluster.GCounterState.CountsEntry
+	nil,                    // 7: cluster.ORSetState.AddedEntry
+	nil,                    // 8: cluster.ORSetState.RemovedEntry
+	nil,                    // 9: cluster.ORSetState.ClockEntry
+	nil,                    // 10: cluster.LWWMapState.EntriesEntry
+	(*anypb.Any)(nil),      // 11: google.protobuf.Any
+}
+var file_distributed_data_proto_depIdxs = []int32{
+	6,  // 0: cluster.GCounterState.counts:type_name -> cluster.GCounterState.CountsEntry
+	0,  // 1: cluster.PNCounterState.increments:type_name -> cluster.GCounterState
+	0,  // 2: cluster.PNCounterState.decrements:type_name -> cluster.GCounterState
+	7,  // 3: cluster.ORSetState.added:type_name -> cluster.ORSetState.AddedEntry
+	8,  // 4: cluster.ORSetState.removed:type_name -> cluster.ORSetState.RemovedEntry
+	9,  // 5: cluster.ORSetState.clock:type_name -> cluster.ORSetState.ClockEntry
+	11, // 6: cluster.LWWRegister.value:type_name -> google.protobuf.Any
+	10, // 7: cluster.LWWMapState.entries:type_name -> cluster.LWWMapState.EntriesEntry
+	2,  // 8: cluster.ORSetState.AddedEntry.value:type_name -> cluster.ORSetTags
+	2,  // 9: cluster.ORSetState.RemovedEntry.value:type_name -> cluster.ORSetTags
+	4,  // 10: cluster.LWWMapState.EntriesEntry.value:type_name -> cluster.LWWRegister
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_distributed_data_proto_init() }
+func file_distributed_data_proto_init() {
+	if File_distributed_data_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_distributed_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCounterState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_distributed_data_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PNCounterState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_distributed_data_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ORSetTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_distributed_data_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ORSetState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_distributed_data_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LWWRegister); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_distributed_data_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LWWMapState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_distributed_data_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_distributed_data_proto_goTypes,
+		DependencyIndexes: file_distributed_data_proto_depIdxs,
+		MessageInfos:      file_distributed_data_proto_msgTypes,
+	}.Build()
+	File_distributed_data_proto = out.File
+	file_distributed_data_proto_rawDesc = nil
+	file_distributed_data_proto_goTypes = nil
+	file_distributed_data_proto_depIdxs = nil
+}
@@ -0,0 +1,310 @@
+package cluster
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ORSet is an observed-remove set of strings replicated to all the members of the cluster. When an element is added
+// and removed concurrently, the addition wins. The removed additions are kept, the state grows with the updates.
+type ORSet struct {
+	replica  *replica[*ORSetState]
+	memberID string
+}
+
+// ORSet returns the set of the key
+func (dd *DistributedData) ORSet(key string) *ORSet {
+	return &ORSet{
+		replica:  getReplica(dd, distributedDataPrefix+"orset/"+key, mergeORSet),
+		memberID: dd.cluster.ActorSystem.ID,
+	}
+}
+
+// Add adds the element to the set
+func (s *ORSet) Add(element string) {
+	s.replica.update(func(state *ORSetState) {
+		if state.Clock == nil {
+			state.Clock = map[string]uint64{}
+		}
+		state.Clock[s.memberID]++
+		tag := fmt.Sprintf("%s/%d", s.memberID, state.Clock[s.memberID])
+		addTags(&state.Added, element, []string{tag})
+	})
+}
+
+// Remove removes the element from the set, as far as this member has seen it added
+func (s *ORSet) Remove(element string) {
+	s.replica.update(func(state *ORSetState) {
+		addTags(&state.Removed, element, state.Added[element].GetTags())
+	})
+}
+
+// Contains reports whether the element is in the set
+func (s *ORSet) Contains(element string) bool {
+	contains := false
+	s.replica.read(func(state *ORSetState) {
+		contains = orSetContains(state, element)
+	})
+
+	return contains
+}
+
+// Elements returns the sorted elements of the set
+func (s *ORSet) Elements() []string {
+	var elements []string
+	s.replica.read(func(state *ORSetState) {
+		for element := range state.Added {
+			if orSetContains(state, element) {
+				elements = append(elements, element)
+			}
+		}
+	})
+	slices.Sort(elements)
+
+	return elements
+}
+
+// Subscribe registers the callback called with the elements of the set when it changes, and returns the function unregistering it
+func (s *ORSet) Subscribe(callback func(elements []string)) func() {
+	return s.replica.subscribe(func() {
+		callback(s.Elements())
+	})
+}
+
+// orSetContains reports whether the element has an addition that was not removed
+func orSetContains(state *ORSetState, element string) bool {
+	removed := state.Removed[element].GetTags()
+	for _, tag := range state.Added[element].GetTags() {
+		if !slices.Contains(removed, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addTags adds the tags of the element that are missing, and reports whether any was
+func addTags(tags *map[string]*ORSetTags, element string, add []string) bool {
+	if len(add) == 0 {
+		return false
+	}
+	if *tags == nil {
+		*tags = map[string]*ORSetTags{}
+	}
+	elementTags, ok := (*tags)[element]
+	if !ok {
+		elementTags = &ORSetTags{}
+		(*tags)[element] = elementTags
+	}
+
+	changed := false
+	for _, tag := range add {
+		if !slices.Contains(elementTags.Tags, tag) {
+			elementTags.Tags = append(elementTags.Tags, tag)
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// mergeORSet keeps the additions and the removals of both states
+func mergeORSet(into *ORSetState, from *ORSetState) bool {
+	changed := false
+	for element, tags := range from.GetAdded() {
+		changed = addTags(&into.Added, element, tags.GetTags()) || changed
+	}
+	for element, tags := range from.GetRemoved() {
+		changed = addTags(&into.Removed, element, tags.GetTags()) || changed
+	}
+	for memberID, clock := range from.GetClock() {
+		if clock > into.Clock[memberID] {
+			if into.Clock == nil {
+				into.Clock = map[string]uint64{}
+			}
+			into.Clock[memberID] = clock
+		}
+	}
+
+	return changed
+}
+
+// LWWMap is a map replicated to all the members of the cluster, the value of a key is the one written last
+// according to the clocks of the members
+type LWWMap[T proto.Message] struct {
+	replica  *replica[*LWWMapState]
+	memberID string
+	logger   *slog.Logger
+}
+
+// GetLWWMap returns the last-writer-wins map of the key, with values of type T
+func GetLWWMap[T proto.Message](dd *DistributedData, key string) *LWWMap[T] {
+	return newLWWMap[T](dd, distributedDataPrefix+"lwwmap/"+key)
+}
+
+func newLWWMap[T proto.Message](dd *DistributedData, gossipKey string) *LWWMap[T] {
+	return &LWWMap[T]{
+		replica:  getReplica(dd, gossipKey, mergeLWWMap),
+		memberID: dd.cluster.ActorSystem.ID,
+		logger:   dd.cluster.Logger(),
+	}
+}
+
+// Set sets the value of the key
+func (m *LWWMap[T]) Set(key string, value T) error {
+	a, err := anypb.New(value)
+	if err != nil {
+		return fmt.Errorf("could not pack value of key %s: %w", key, err)
+	}
+
+	m.write(key, a)
+	return nil
+}
+
+// Delete deletes the key
+func (m *LWWMap[T]) Delete(key string) {
+	m.write(key, nil)
+}
+
+func (m *LWWMap[T]) write(key string, value *anypb.Any) {
+	m.replica.update(func(state *LWWMapState) {
+		timestamp := time.Now().UnixNano()
+		// the write follows the one it replaces, even when the clock of the member is behind
+		if current, ok := state.Entries[key]; ok && timestamp <= current.TimestampUnixNanoseconds {
+			timestamp = current.TimestampUnixNanoseconds + 1
+		}
+		if state.Entries == nil {
+			state.Entries = map[string]*LWWRegister{}
+		}
+		state.Entries[key] = &LWWRegister{Value: value, TimestampUnixNanoseconds: timestamp, MemberId: m.memberID}
+	})
+}
+
+// Get returns the value of the key, and whether the map has it
+func (m *LWWMap[T]) Get(key string) (T, bool) {
+	var value T
+	ok := false
+	m.replica.read(func(state *LWWMapState) {
+		value, ok = m.unpack(key, state.Entries[key])
+	})
+
+	return value, ok
+}
+
+// Entries returns the keys of the map with their values
+func (m *LWWMap[T]) Entries() map[string]T {
+	entries := map[string]T{}
+	m.replica.read(func(state *LWWMapState) {
+		for key, register := range state.Entries {
+			if value, ok := m.unpack(key, register); ok {
+				entries[key] = value
+			}
+		}
+	})
+
+	return entries
+}
+
+// Subscribe registers the callback called with the entries of the map when it changes, and returns the function unregistering it
+func (m *LWWMap[T]) Subscribe(callback func(entries map[string]T)) func() {
+	return m.replica.subscribe(func() {
+		callback(m.Entries())
+	})
+}
+
+func (m *LWWMap[T]) unpack(key string, register *LWWRegister) (T, bool) {
+	var value T
+	if register.GetValue() == nil {
+		return value, false
+	}
+
+	value = value.ProtoReflect().New().Interface().(T)
+	if err := register.Value.UnmarshalTo(value); err != nil {
+		m.logger.Warn("could not unpack distributed data value", slog.String("key", key), slog.Any("error", err))
+		return value, false
+	}
+
+	return value, true
+}
+
+// newerRegister reports whether the register was written after the other one
+func newerRegister(register *LWWRegister, other *LWWRegister) bool {
+	if register.TimestampUnixNanoseconds != other.TimestampUnixNanoseconds {
+		return register.TimestampUnixNanoseconds > other.TimestampUnixNanoseconds
+	}
+
+	return register.MemberId > other.MemberId
+}
+
+// mergeLWWMap keeps the register written last for each key
+func mergeLWWMap(into *LWWMapState, from *LWWMapState) bool {
+	changed := false
+	for key, register := range from.GetEntries() {
+		if current, ok := into.Entries[key]; ok && !newerRegister(register, current) {
+			continue
+		}
+		if into.Entries == nil {
+			into.Entries = map[string]*LWWRegister{}
+		}
+		into.Entries[key] = register
+		changed = true
+	}
+
+	return changed
+}
+
+// Flags are boolean flags, such as feature flags, replicated to all the members of the cluster.
+// The state of a flag is the one set last.
+type Flags struct {
+	flags *LWWMap[*wrapperspb.BoolValue]
+}
+
+// Flags returns the flags of the key
+func (dd *DistributedData) Flags(key string) *Flags {
+	return &Flags{flags: newLWWMap[*wrapperspb.BoolValue](dd, distributedDataPrefix+"flags/"+key)}
+}
+
+// Set sets the flag
+func (f *Flags) Set(name string, enabled bool) {
+	// a BoolValue always packs
+	_ = f.flags.Set(name, wrapperspb.Bool(enabled))
+}
+
+// Enable enables the flag
+func (f *Flags) Enable(name string) {
+	f.Set(name, true)
+}
+
+// Disable disables the flag
+func (f *Flags) Disable(name string) {
+	f.Set(name, false)
+}
+
+// Enabled reports whether the flag is enabled, a flag that was never set is disabled
+func (f *Flags) Enabled(name string) bool {
+	value, ok := f.flags.Get(name)
+	return ok && value.Value
+}
+
+// All returns the flags that were set
+func (f *Flags) All() map[string]bool {
+	all := map[string]bool{}
+	for name, value := range f.flags.Entries() {
+		all[name] = value.Value
+	}
+
+	return all
+}
+
+// Subscribe registers the callback called with the flags when they change, and returns the function unregistering it
+func (f *Flags) Subscribe(callback func(flags map[string]bool)) func() {
+	return f.flags.replica.subscribe(func() {
+		callback(f.All())
+	})
+}
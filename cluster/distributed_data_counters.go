@@ -0,0 +1,135 @@
+package cluster
+
+// GCounter is a grow-only counter replicated to all the members of the cluster
+type GCounter struct {
+	replica  *replica[*GCounterState]
+	memberID string
+}
+
+// GCounter returns the grow-only counter of the key
+func (dd *DistributedData) GCounter(key string) *GCounter {
+	return &GCounter{
+		replica:  getReplica(dd, distributedDataPrefix+"gcounter/"+key, mergeGCounter),
+		memberID: dd.cluster.ActorSystem.ID,
+	}
+}
+
+// Increment adds the delta to the counter
+func (c *GCounter) Increment(delta uint64) {
+	c.replica.update(func(state *GCounterState) {
+		incrementGCounter(state, c.memberID, delta)
+	})
+}
+
+// Value returns the value of the counter, with the increments gossiped so far
+func (c *GCounter) Value() uint64 {
+	var value uint64
+	c.replica.read(func(state *GCounterState) {
+		value = gCounterValue(state)
+	})
+
+	return value
+}
+
+// Subscribe registers the callback called with the value of the counter when it changes, and returns the function unregistering it
+func (c *GCounter) Subscribe(callback func(value uint64)) func() {
+	return c.replica.subscribe(func() {
+		callback(c.Value())
+	})
+}
+
+func incrementGCounter(state *GCounterState, memberID string, delta uint64) {
+	if state.Counts == nil {
+		state.Counts = map[string]uint64{}
+	}
+	state.Counts[memberID] += delta
+}
+
+func gCounterValue(state *GCounterState) uint64 {
+	var value uint64
+	for _, count := range state.GetCounts() {
+		value += count
+	}
+
+	return value
+}
+
+// mergeGCounter keeps the highest count of each member
+func mergeGCounter(into *GCounterState, from *GCounterState) bool {
+	changed := false
+	for memberID, count := range from.GetCounts() {
+		if count > into.Counts[memberID] {
+			if into.Counts == nil {
+				into.Counts = map[string]uint64{}
+			}
+			into.Counts[memberID] = count
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// PNCounter is a counter that can be incremented and decremented, replicated to all the members of the cluster
+type PNCounter struct {
+	replica  *replica[*PNCounterState]
+	memberID string
+}
+
+// PNCounter returns the counter of the key
+func (dd *DistributedData) PNCounter(key string) *PNCounter {
+	return &PNCounter{
+		replica:  getReplica(dd, distributedDataPrefix+"pncounter/"+key, mergePNCounter),
+		memberID: dd.cluster.ActorSystem.ID,
+	}
+}
+
+// Increment adds the delta to the counter
+func (c *PNCounter) Increment(delta uint64) {
+	c.replica.update(func(state *PNCounterState) {
+		if state.Increments == nil {
+			state.Increments = &GCounterState{}
+		}
+		incrementGCounter(state.Increments, c.memberID, delta)
+	})
+}
+
+// Decrement subtracts the delta from the counter
+func (c *PNCounter) Decrement(delta uint64) {
+	c.replica.update(func(state *PNCounterState) {
+		if state.Decrements == nil {
+			state.Decrements = &GCounterState{}
+		}
+		incrementGCounter(state.Decrements, c.memberID, delta)
+	})
+}
+
+// Value returns the value of the counter, with the updates gossiped so far
+func (c *PNCounter) Value() int64 {
+	var value int64
+	c.replica.read(func(state *PNCounterState) {
+		value = int64(gCounterValue(state.GetIncrements())) - int64(gCounterValue(state.GetDecrements()))
+	})
+
+	return value
+}
+
+// Subscribe registers the callback called with the value of the counter when it changes, and returns the function unregistering it
+func (c *PNCounter) Subscribe(callback func(value int64)) func() {
+	return c.replica.subscribe(func() {
+		callback(c.Value())
+	})
+}
+
+func mergePNCounter(into *PNCounterState, from *PNCounterState) bool {
+	if into.Increments == nil {
+		into.Increments = &GCounterState{}
+	}
+	if into.Decrements == nil {
+		into.Decrements = &GCounterState{}
+	}
+	incremented := mergeGCounter(into.Increments, from.GetIncrements())
+	decremented := mergeGCounter(into.Decrements, from.GetDecrements())
+
+	return incremented || decremented
+}
@@ -0,0 +1,68 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeGCounter_KeepsHighestCountOfEachMember(t *testing.T) {
+	into := &GCounterState{Counts: map[string]uint64{"a": 3, "b": 1}}
+	from := &GCounterState{Counts: map[string]uint64{"a": 2, "b": 4, "c": 1}}
+
+	assert.True(t, mergeGCounter(into, from))
+	assert.Equal(t, map[string]uint64{"a": 3, "b": 4, "c": 1}, into.Counts)
+	assert.Equal(t, uint64(8), gCounterValue(into))
+
+	// merging the same state again changes nothing
+	assert.False(t, mergeGCounter(into, from))
+}
+
+func TestMergePNCounter_MergesIncrementsAndDecrements(t *testing.T) {
+	into := &PNCounterState{}
+	from := &PNCounterState{
+		Increments: &GCounterState{Counts: map[string]uint64{"a": 5}},
+		Decrements: &GCounterState{Counts: map[string]uint64{"b": 2}},
+	}
+
+	assert.True(t, mergePNCounter(into, from))
+	assert.Equal(t, uint64(5), gCounterValue(into.Increments))
+	assert.Equal(t, uint64(2), gCounterValue(into.Decrements))
+}
+
+func TestMergeORSet_ConcurrentAddWinsOverRemove(t *testing.T) {
+	// a removed x after seeing the addition a/1, b added x again concurrently
+	a := &ORSetState{Clock: map[string]uint64{"a": 1}}
+	addTags(&a.Added, "x", []string{"a/1"})
+	addTags(&a.Removed, "x", []string{"a/1"})
+	b := &ORSetState{Clock: map[string]uint64{"a": 1, "b": 1}}
+	addTags(&b.Added, "x", []string{"a/1", "b/1"})
+
+	assert.False(t, orSetContains(a, "x"))
+	assert.True(t, mergeORSet(a, b))
+	assert.True(t, orSetContains(a, "x"))
+	assert.Equal(t, uint64(1), a.Clock["b"])
+
+	assert.True(t, mergeORSet(b, a))
+	assert.True(t, orSetContains(b, "x"))
+	assert.False(t, mergeORSet(b, a))
+}
+
+func TestMergeLWWMap_KeepsRegisterWrittenLast(t *testing.T) {
+	into := &LWWMapState{Entries: map[string]*LWWRegister{
+		"k": {TimestampUnixNanoseconds: 10, MemberId: "a"},
+		"l": {TimestampUnixNanoseconds: 10, MemberId: "a"},
+	}}
+	from := &LWWMapState{Entries: map[string]*LWWRegister{
+		"k": {TimestampUnixNanoseconds: 5, MemberId: "b"},
+		// the member id breaks the tie
+		"l": {TimestampUnixNanoseconds: 10, MemberId: "b"},
+		"m": {TimestampUnixNanoseconds: 1, MemberId: "b"},
+	}}
+
+	assert.True(t, mergeLWWMap(into, from))
+	assert.Equal(t, "a", into.Entries["k"].MemberId)
+	assert.Equal(t, "b", into.Entries["l"].MemberId)
+	assert.Equal(t, "b", into.Entries["m"].MemberId)
+	assert.False(t, mergeLWWMap(into, from))
+}
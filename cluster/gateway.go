@@ -0,0 +1,233 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+)
+
+const (
+	gatewayActorName = "gateway"
+
+	gatewayIdentityHeader = "cluster-gateway-identity"
+	gatewayKindHeader     = "cluster-gateway-kind"
+)
+
+// startGateway spawns the actor forwarding the requests of gateway clients to the grains
+func (c *Cluster) startGateway() {
+	props := actor.PropsFromProducer(func() actor.Actor { return &gatewayActor{cluster: c} })
+	if _, err := c.ActorSystem.Root.SpawnNamed(props, gatewayActorName); err != nil {
+		panic(err) // let it crash
+	}
+}
+
+// StartGatewayClient starts a client that joins neither the membership nor the gossip, and needs no provider,
+// identity lookup or inbound port: it connects to the gateway seeds, which forward its grain requests and send
+// the responses back over the same connections.
+//
+//	config := cluster.Configure("my-cluster", nil, nil, remote.Configure("localhost", 0),
+//		cluster.WithGatewaySeeds("10.0.0.1:8090", "10.0.0.2:8090"))
+//	c := cluster.New(system, config)
+//	c.StartGatewayClient()
+//
+// A request goes to the next seed once the current one cannot be reached.
+func (c *Cluster) StartGatewayClient() {
+	if len(c.Config.GatewaySeeds) == 0 {
+		panic(errors.New("no gateway seeds configured"))
+	}
+
+	c.Remote = remote.NewRemote(c.ActorSystem, c.Config.RemoteConfig)
+	c.Remote.StartClient()
+	c.gatewayClient = true
+	c.context = newGatewayContext(c, c.Config.GatewaySeeds)
+
+	c.Logger().Info("Starting Proto.Actor cluster gateway client", slog.String("address", c.ActorSystem.Address()),
+		slog.Any("seeds", c.Config.GatewaySeeds))
+}
+
+// gatewayActor forwards the requests of gateway clients to the grains named in their headers.
+// At most GatewayConcurrency requests are in flight, up to GatewayQueueSize others wait in turn.
+type gatewayActor struct {
+	cluster  *Cluster
+	inFlight int
+	pending  []*gatewayRequest
+}
+
+// gatewayRequest is a request of a gateway client waiting to be forwarded
+type gatewayRequest struct {
+	identity string
+	kind     string
+	message  interface{}
+	sender   *actor.PID
+	deadline time.Time // zero when the request has no deadline
+	opts     []GrainCallOption
+}
+
+// gatewayRequestDone tells the gateway a forwarded request completed
+type gatewayRequestDone struct{}
+
+func (g *gatewayActor) Receive(ctx actor.Context) {
+	if _, ok := ctx.Message().(*gatewayRequestDone); ok {
+		g.inFlight--
+		g.forwardPending(ctx)
+		return
+	}
+
+	header := ctx.MessageHeader()
+	if header == nil || ctx.Sender() == nil {
+		return
+	}
+
+	identity, kind := header.Get(gatewayIdentityHeader), header.Get(gatewayKindHeader)
+	if identity == "" || kind == "" {
+		return
+	}
+
+	request := &gatewayRequest{identity: identity, kind: kind, message: ctx.Message(), sender: ctx.Sender()}
+	if deadline, ok := headerDeadline(header); ok {
+		request.deadline = deadline
+		request.opts = append(request.opts, WithDeadline(deadline))
+	}
+	if key := header.Get(IdempotencyKeyHeader); key != "" {
+		request.opts = append(request.opts, WithIdempotencyKey(key))
+	}
+
+	if limit := g.cluster.Config.GatewayConcurrency; limit > 0 && g.inFlight >= limit {
+		if size := g.cluster.Config.GatewayQueueSize; size > 0 && len(g.pending) >= size {
+			ctx.Respond(NewGrainErrorResponse(ErrorReason_RESOURCE_EXHAUSTED, "gateway queue is full"))
+			return
+		}
+		g.pending = append(g.pending, request)
+		return
+	}
+	g.forward(ctx, request)
+}
+
+// forwardPending forwards the next pending request, the requests whose deadline expired while waiting are dropped,
+// their clients stopped waiting for them
+func (g *gatewayActor) forwardPending(ctx actor.Context) {
+	for len(g.pending) > 0 {
+		request := g.pending[0]
+		g.pending[0] = nil
+		g.pending = g.pending[1:]
+		if !request.deadline.IsZero() && !time.Now().Before(request.deadline) {
+			ctx.Logger().Debug("Dropping expired gateway request", slog.String("identity", request.identity), slog.String("kind", request.kind))
+			continue
+		}
+		g.forward(ctx, request)
+		return
+	}
+}
+
+// forward sends the request to the grain, the gateway keeps receiving the other requests meanwhile
+func (g *gatewayActor) forward(ctx actor.Context, request *gatewayRequest) {
+	g.inFlight++
+	self, root := ctx.Self(), ctx.ActorSystem().Root
+	go func() {
+		res, err := g.cluster.Request(request.identity, request.kind, request.message, request.opts...)
+		if err != nil {
+			res = gatewayErrorResponse(err)
+		}
+		root.Send(request.sender, res)
+		root.Send(self, &gatewayRequestDone{})
+	}()
+}
+
+// gatewayErrorResponse turns the error of a forwarded request into a response the client can receive
+func gatewayErrorResponse(err error) *GrainErrorResponse {
+	var grainErr *GrainErrorResponse
+	switch {
+	case errors.As(err, &grainErr):
+		return grainErr
+	case errors.Is(err, context.DeadlineExceeded):
+		return NewGrainErrorResponse(ErrorReason_DEADLINE_EXCEEDED, err.Error())
+	default:
+		return NewGrainErrorResponse(ErrorReason_UNAVAILABLE, err.Error())
+	}
+}
+
+// gatewayContext sends the grain requests of a gateway client through the gateway of one of its seeds
+type gatewayContext struct {
+	cluster *Cluster
+	seeds   []string
+
+	mutex   sync.Mutex
+	current int // index of the seed the requests are sent to
+}
+
+var _ Context = (*gatewayContext)(nil)
+
+func newGatewayContext(cluster *Cluster, seeds []string) *gatewayContext {
+	return &gatewayContext{
+		cluster: cluster,
+		seeds:   seeds,
+	}
+}
+
+// gateway returns the gateway the requests are sent to
+func (g *gatewayContext) gateway() *actor.PID {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return actor.NewPID(g.seeds[g.current], gatewayActorName)
+}
+
+// failover moves to the next seed, unless another request already moved away from the failed gateway
+func (g *gatewayContext) failover(failed *actor.PID) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.seeds[g.current] == failed.Address {
+		g.current = (g.current + 1) % len(g.seeds)
+	}
+}
+
+func gatewayHeaders(identity string, kind string) map[string]string {
+	return map[string]string{
+		gatewayIdentityHeader: identity,
+		gatewayKindHeader:     kind,
+	}
+}
+
+func (g *gatewayContext) Request(identity string, kind string, message interface{}, opts ...GrainCallOption) (interface{}, error) {
+	callConfig := DefaultGrainCallConfig(g.cluster)
+	for _, o := range opts {
+		o(callConfig)
+	}
+
+	deadline := callConfig.deadline(time.Now())
+	headers := gatewayHeaders(identity, kind)
+
+	var gateway *actor.PID
+	attempt := func() (interface{}, error) {
+		gateway = g.gateway()
+		return sendGrainRequest(callConfig, gateway, message, deadline, headers).Result()
+	}
+	onRetry := func(err error) {
+		g.cluster.Logger().Debug("Gateway request failed, trying the next seed", slog.Any("gateway", gateway), slog.Any("error", err))
+		g.failover(gateway)
+	}
+
+	resp, err := retryRequest(callConfig, deadline, attempt, onRetry)
+	if err != nil {
+		g.cluster.Logger().Warn("Gateway request failed", slog.String("identity", identity), slog.String("kind", kind), slog.Any("error", err))
+	}
+
+	return resp, err
+}
+
+func (g *gatewayContext) RequestFuture(identity string, kind string, message interface{}, opts ...GrainCallOption) (*actor.Future, error) {
+	callConfig := DefaultGrainCallConfig(g.cluster)
+	for _, o := range opts {
+		o(callConfig)
+	}
+
+	deadline := callConfig.deadline(time.Now())
+
+	return sendGrainRequest(callConfig, g.gateway(), message, deadline, gatewayHeaders(identity, kind)), nil
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.31.0
 // 	protoc        v4.24.3
 // source: gossip.proto
 
@@ -292,6 +292,111 @@ func (x *GossipDeltaValue) GetEntries() []*GossipDeltaValue_GossipDeltaEntry {
 	return nil
 }
 
+// value of a key value store entry kept in the gossip state
+// the entry written last, across the members, is the value of the key, the member id breaks ties
+type GossipStoreEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value                   *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` //empty when the key was cleared
+	WrittenUnixMilliseconds int64      `protobuf:"varint,2,opt,name=written_unix_milliseconds,json=writtenUnixMilliseconds,proto3" json:"written_unix_milliseconds,omitempty"`
+}
+
+func (x *GossipStoreEntry) Reset() {
+	*x = GossipStoreEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gossip_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipStoreEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipStoreEntry) ProtoMessage() {}
+
+func (x *GossipStoreEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gossip_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipStoreEntry.ProtoReflect.Descriptor instead.
+func (*GossipStoreEntry) Descriptor() ([]byte, []int) {
+	return file_gossip_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GossipStoreEntry) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GossipStoreEntry) GetWrittenUnixMilliseconds() int64 {
+	if x != nil {
+		return x.WrittenUnixMilliseconds
+	}
+	return 0
+}
+
+// entries of a key value store written by a member, by key
+type GossipStoreEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries map[string]*GossipStoreEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GossipStoreEntries) Reset() {
+	*x = GossipStoreEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gossip_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipStoreEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipStoreEntries) ProtoMessage() {}
+
+func (x *GossipStoreEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_gossip_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipStoreEntries.ProtoReflect.Descriptor instead.
+func (*GossipStoreEntries) Descriptor() ([]byte, []int) {
+	return file_gossip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GossipStoreEntries) GetEntries() map[string]*GossipStoreEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
 type GossipState_GossipMemberState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -303,7 +408,7 @@ type GossipState_GossipMemberState struct {
 func (x *GossipState_GossipMemberState) Reset() {
 	*x = GossipState_GossipMemberState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gossip_proto_msgTypes[5]
+		mi := &file_gossip_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -316,7 +421,7 @@ func (x *GossipState_GossipMemberState) String() string {
 func (*GossipState_GossipMemberState) ProtoMessage() {}
 
 func (x *GossipState_GossipMemberState) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[5]
+	mi := &file_gossip_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +458,7 @@ type GossipDeltaValue_GossipDeltaEntry struct {
 func (x *GossipDeltaValue_GossipDeltaEntry) Reset() {
 	*x = GossipDeltaValue_GossipDeltaEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gossip_proto_msgTypes[8]
+		mi := &file_gossip_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -366,7 +471,7 @@ func (x *GossipDeltaValue_GossipDeltaEntry) String() string {
 func (*GossipDeltaValue_GossipDeltaEntry) ProtoMessage() {}
 
 func (x *GossipDeltaValue_GossipDeltaEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[8]
+	mi := &file_gossip_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,10 +561,29 @@ var file_gossip_proto_rawDesc = []byte{
 	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65,
 	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x42, 0x2c, 0x5a, 0x2a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x73, 0x79, 0x6e, 0x6b, 0x72, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x7a, 0x0a, 0x10, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x3a, 0x0a, 0x19, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x17, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x55, 0x6e, 0x69, 0x78,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x12, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x55, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x2c,
+	0x5a, 0x2a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73,
+	0x79, 0x6e, 0x6b, 0x72, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -474,33 +598,39 @@ func file_gossip_proto_rawDescGZIP() []byte {
 	return file_gossip_proto_rawDescData
 }
 
-var file_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_gossip_proto_goTypes = []interface{}{
 	(*GossipRequest)(nil),                 // 0: cluster.GossipRequest
 	(*GossipResponse)(nil),                // 1: cluster.GossipResponse
 	(*GossipState)(nil),                   // 2: cluster.GossipState
 	(*GossipKeyValue)(nil),                // 3: cluster.GossipKeyValue
 	(*GossipDeltaValue)(nil),              // 4: cluster.GossipDeltaValue
-	(*GossipState_GossipMemberState)(nil), // 5: cluster.GossipState.GossipMemberState
-	nil,                                   // 6: cluster.GossipState.MembersEntry
-	nil,                                   // 7: cluster.GossipState.GossipMemberState.ValuesEntry
-	(*GossipDeltaValue_GossipDeltaEntry)(nil), // 8: cluster.GossipDeltaValue.GossipDeltaEntry
-	(*anypb.Any)(nil),                         // 9: google.protobuf.Any
+	(*GossipStoreEntry)(nil),              // 5: cluster.GossipStoreEntry
+	(*GossipStoreEntries)(nil),            // 6: cluster.GossipStoreEntries
+	(*GossipState_GossipMemberState)(nil), // 7: cluster.GossipState.GossipMemberState
+	nil,                                   // 8: cluster.GossipState.MembersEntry
+	nil,                                   // 9: cluster.GossipState.GossipMemberState.ValuesEntry
+	(*GossipDeltaValue_GossipDeltaEntry)(nil), // 10: cluster.GossipDeltaValue.GossipDeltaEntry
+	nil,               // 11: cluster.GossipStoreEntries.EntriesEntry
+	(*anypb.Any)(nil), // 12: google.protobuf.Any
 }
 var file_gossip_proto_depIdxs = []int32{
-	2, // 0: cluster.GossipRequest.state:type_name -> cluster.GossipState
-	2, // 1: cluster.GossipResponse.state:type_name -> cluster.GossipState
-	6, // 2: cluster.GossipState.members:type_name -> cluster.GossipState.MembersEntry
-	9, // 3: cluster.GossipKeyValue.value:type_name -> google.protobuf.Any
-	8, // 4: cluster.GossipDeltaValue.entries:type_name -> cluster.GossipDeltaValue.GossipDeltaEntry
-	7, // 5: cluster.GossipState.GossipMemberState.values:type_name -> cluster.GossipState.GossipMemberState.ValuesEntry
-	5, // 6: cluster.GossipState.MembersEntry.value:type_name -> cluster.GossipState.GossipMemberState
-	3, // 7: cluster.GossipState.GossipMemberState.ValuesEntry.value:type_name -> cluster.GossipKeyValue
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2,  // 0: cluster.GossipRequest.state:type_name -> cluster.GossipState
+	2,  // 1: cluster.GossipResponse.state:type_name -> cluster.GossipState
+	8,  // 2: cluster.GossipState.members:type_name -> cluster.GossipState.MembersEntry
+	12, // 3: cluster.GossipKeyValue.value:type_name -> google.protobuf.Any
+	10, // 4: cluster.GossipDeltaValue.entries:type_name -> cluster.GossipDeltaValue.GossipDeltaEntry
+	12, // 5: cluster.GossipStoreEntry.value:type_name -> google.protobuf.Any
+	11, // 6: cluster.GossipStoreEntries.entries:type_name -> cluster.GossipStoreEntries.EntriesEntry
+	9,  // 7: cluster.GossipState.GossipMemberState.values:type_name -> cluster.GossipState.GossipMemberState.ValuesEntry
+	7,  // 8: cluster.GossipState.MembersEntry.value:type_name -> cluster.GossipState.GossipMemberState
+	3,  // 9: cluster.GossipState.GossipMemberState.ValuesEntry.value:type_name -> cluster.GossipKeyValue
+	5,  // 10: cluster.GossipStoreEntries.EntriesEntry.value:type_name -> cluster.GossipStoreEntry
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_gossip_proto_init() }
@@ -570,6 +700,30 @@ func file_gossip_proto_init() {
 			}
 		}
 		file_gossip_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipStoreEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gossip_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipStoreEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gossip_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GossipState_GossipMemberState); i {
 			case 0:
 				return &v.state
@@ -581,7 +735,7 @@ func file_gossip_proto_init() {
 				return nil
 			}
 		}
-		file_gossip_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_gossip_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GossipDeltaValue_GossipDeltaEntry); i {
 			case 0:
 				return &v.state
@@ -600,7 +754,7 @@ func file_gossip_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gossip_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -239,10 +239,7 @@ breakLoop:
 			g.blockExpiredHeartbeats()
 			g.blockGracefullyLeft()
 
-			g.SetState(HearthbeatKey, &MemberHeartbeat{
-				// todo collect the actor statistics
-				ActorStatistics: &ActorStatistics{},
-			})
+			g.SetState(HearthbeatKey, g.cluster.memberHeartbeat())
 			g.SendState()
 		}
 	}
@@ -11,25 +11,29 @@ type GrainCallConfig struct {
 	Timeout     time.Duration
 	RetryAction func(n int) int
 	Context     actor.SenderContext
+	Concurrency int       // requests in flight at most for RequestMany
+	Deadline    time.Time // the call fails once reached, if not zero
+	// IdempotencyKey is sent with the request and its retries, if not empty
+	IdempotencyKey string
+	// ShouldRetry reports whether a failed request is retried, IsTransient by default
+	ShouldRetry func(err error) bool
 }
 
 type GrainCallOption func(config *GrainCallConfig)
 
-var defaultGrainCallOptions *GrainCallConfig
-
+// DefaultGrainCallConfig returns a new call config of the cluster, the call options modify it for a single call
 func DefaultGrainCallConfig(cluster *Cluster) *GrainCallConfig {
-	if defaultGrainCallOptions == nil {
-		defaultGrainCallOptions = NewGrainCallOptions(cluster)
-	}
-	return defaultGrainCallOptions
+	return NewGrainCallOptions(cluster)
 }
 
 func NewGrainCallOptions(cluster *Cluster) *GrainCallConfig {
 	return &GrainCallConfig{
 		// TODO: set default in config
-		RetryCount: 3,
-		Context:    cluster.ActorSystem.Root,
-		Timeout:    cluster.Config.RequestTimeoutTime,
+		RetryCount:  3,
+		Context:     cluster.ActorSystem.Root,
+		Timeout:     cluster.Config.RequestTimeoutTime,
+		Concurrency: 10,
+		ShouldRetry: IsTransient,
 		RetryAction: func(i int) int {
 			i++
 			time.Sleep(time.Duration(i * i * 50))
@@ -56,6 +60,16 @@ func WithRetryAction(act func(i int) int) GrainCallOption {
 	}
 }
 
+// WithConcurrency bounds the number of requests RequestMany has in flight
+func WithConcurrency(concurrency int) GrainCallOption {
+	return func(config *GrainCallConfig) {
+		config.Concurrency = concurrency
+	}
+}
+
+// WithContext sends the request with the context, by default the root context of the actor system.
+// A grain calling other grains passes its own context so that they share the deadline of the request it processes,
+// generated grain clients obtained from the grain context do so by themselves.
 func WithContext(ctx actor.SenderContext) GrainCallOption {
 	return func(config *GrainCallConfig) {
 		config.Context = ctx
@@ -0,0 +1,208 @@
+package cluster
+
+import (
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const handoffActorName = "handoff"
+
+// startHandoff spawns the actor receiving the grains of leaving members
+func (c *Cluster) startHandoff() {
+	props := actor.PropsFromProducer(func() actor.Actor { return &handoffActor{cluster: c} })
+	if _, err := c.ActorSystem.Root.SpawnNamed(props, handoffActorName); err != nil {
+		panic(err) // let it crash
+	}
+}
+
+// handoff collects the state of the grains on this member with Rebalancing and stops them, their new activations
+// fetch it from this member when they start. A remaining member activates the grains once this member is no longer
+// placeable. It returns the future of the handoff, or nil if there is nothing to hand off.
+func (c *Cluster) handoff(timeout time.Duration) *actor.Future {
+	activations := make([]*Activation, 0)
+	c.activations.Range(func(_, value interface{}) bool {
+		activations = append(activations, value.(*Activation))
+		return true
+	})
+	if len(activations) == 0 {
+		return nil
+	}
+
+	receiver := c.handoffReceiver()
+	if receiver == "" {
+		c.Logger().Warn("No member to hand off grains to")
+		return nil
+	}
+
+	c.Logger().Info("Handing off grains", slog.Int("grains", len(activations)), slog.String("receiver", receiver))
+	states := c.collectGrainStates(activations)
+
+	request := &HandoffRequest{
+		MemberId:            c.ActorSystem.ID,
+		Grains:              make([]*Rebalanced, len(activations)),
+		TimeoutMilliseconds: timeout.Milliseconds(),
+	}
+	for i, activation := range activations {
+		// the state is kept before the grain stops, a request may activate it elsewhere right after
+		c.handedOff.Store(activation.ClusterIdentity.AsKey(), &Rebalanced{ClusterIdentity: activation.ClusterIdentity, State: states[i]})
+		request.Grains[i] = &Rebalanced{ClusterIdentity: activation.ClusterIdentity}
+	}
+
+	stopped := make([]*actor.Future, len(activations))
+	for i, activation := range activations {
+		stopped[i] = c.ActorSystem.Root.PoisonFuture(activation.Pid)
+	}
+	for _, future := range stopped {
+		_ = future.Wait()
+	}
+
+	return c.ActorSystem.Root.RequestFuture(actor.NewPID(receiver, handoffActorName), request, timeout)
+}
+
+// awaitHandoff waits for the remaining member to activate the handed off grains
+func (c *Cluster) awaitHandoff(future *actor.Future) {
+	res, err := future.Result()
+	if err != nil {
+		c.Logger().Warn("Handoff did not complete, grains will be activated on demand", slog.Any("error", err))
+		return
+	}
+
+	if response, ok := res.(*HandoffResponse); ok {
+		c.Logger().Info("Handed off grains", slog.Int("activated", int(response.Activated)), slog.Int("failed", int(response.Failed)))
+	}
+}
+
+// handoffReceiver returns the address of the remaining member with the lowest id
+func (c *Cluster) handoffReceiver() string {
+	// members are sorted by id
+	for _, member := range c.MemberList.Members().Members() {
+		if member.Id != c.ActorSystem.ID {
+			return member.Address()
+		}
+	}
+
+	return ""
+}
+
+// collectGrainStates sends Rebalancing to the grains and returns their replies, nil for grains without state.
+// The grains which do not reply within the state timeout have no state.
+func (c *Cluster) collectGrainStates(activations []*Activation) []*anypb.Any {
+	states := make([]*anypb.Any, len(activations))
+	futures := make([]*actor.Future, len(activations))
+	for i, activation := range activations {
+		futures[i] = c.ActorSystem.Root.RequestFuture(activation.Pid, &Rebalancing{ClusterIdentity: activation.ClusterIdentity}, c.Config.HandoffStateTimeout)
+	}
+
+	for i, future := range futures {
+		res, err := future.Result()
+		if err != nil {
+			continue
+		}
+
+		msg, ok := res.(proto.Message)
+		if !ok {
+			c.Logger().Warn("Grain replied to Rebalancing with a non proto message", slog.String("identity", activations[i].ClusterIdentity.ToShortString()))
+			continue
+		}
+
+		state, err := anypb.New(msg)
+		if err != nil {
+			c.Logger().Error("Failed to pack grain state", slog.String("identity", activations[i].ClusterIdentity.ToShortString()), slog.Any("error", err))
+			continue
+		}
+		states[i] = state
+	}
+
+	return states
+}
+
+// handedOffGrain fetches the state of the grain from the leaving members, nil when none of them handed it off
+func (c *Cluster) handedOffGrain(identity *ClusterIdentity) *Rebalanced {
+	if c.Config.HandoffTimeout <= 0 {
+		return nil
+	}
+
+	for _, member := range c.MemberList.Members().Members() {
+		if member.Id == c.ActorSystem.ID || c.MemberList.State(member.Id) != MemberState_Leaving {
+			continue
+		}
+
+		request := &HandoffStateRequest{ClusterIdentity: identity}
+		res, err := c.ActorSystem.Root.RequestFuture(actor.NewPID(member.Address(), handoffActorName), request, c.Config.HandoffStateTimeout).Result()
+		if err != nil {
+			c.Logger().Warn("Failed to fetch the handed off state of grain", slog.String("identity", identity.ToShortString()), slog.String("member", member.Id), slog.Any("error", err))
+			continue
+		}
+		if response, ok := res.(*HandoffStateResponse); ok && response.Grain != nil {
+			return response.Grain
+		}
+	}
+
+	return nil
+}
+
+// handoffActor activates the grains handed off by a leaving member on their new owners,
+// and hands the state of the grains this member handed off to their new activations
+type handoffActor struct {
+	cluster *Cluster
+}
+
+func (h *handoffActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *HandoffRequest:
+		sender := ctx.Sender()
+		// activating the grains waits for the placement, keep the actor responsive meanwhile
+		go func() {
+			response := h.activate(msg)
+			if sender != nil {
+				h.cluster.ActorSystem.Root.Send(sender, response)
+			}
+		}()
+	case *HandoffStateRequest:
+		// the state goes to the first activation asking for it
+		response := &HandoffStateResponse{}
+		if grain, ok := h.cluster.handedOff.LoadAndDelete(msg.ClusterIdentity.AsKey()); ok {
+			response.Grain = grain.(*Rebalanced)
+		}
+		ctx.Respond(response)
+	}
+}
+
+func (h *handoffActor) activate(msg *HandoffRequest) *HandoffResponse {
+	deadline := time.Now().Add(time.Duration(msg.TimeoutMilliseconds) * time.Millisecond)
+
+	// the grains must be placed on the remaining members, wait until the leaving member is no longer placeable
+	for h.cluster.MemberList.State(msg.MemberId) == MemberState_Up {
+		if time.Now().After(deadline) {
+			h.cluster.Logger().Warn("Handoff timed out waiting for the member to leave", slog.String("member", msg.MemberId))
+			return &HandoffResponse{Failed: int32(len(msg.Grains))}
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	var activated, failed int32
+	var wg sync.WaitGroup
+	for _, grain := range msg.Grains {
+		wg.Add(1)
+		go func(grain *Rebalanced) {
+			defer wg.Done()
+
+			ci := grain.ClusterIdentity
+			if _, err := h.cluster.Request(ci.Identity, ci.Kind, grain, WithTimeout(time.Until(deadline))); err != nil {
+				h.cluster.Logger().Warn("Failed to activate handed off grain", slog.String("identity", ci.ToShortString()), slog.Any("error", err))
+				atomic.AddInt32(&failed, 1)
+				return
+			}
+			atomic.AddInt32(&activated, 1)
+		}(grain)
+	}
+	wg.Wait()
+
+	return &HandoffResponse{Activated: activated, Failed: failed}
+}
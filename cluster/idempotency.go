@@ -0,0 +1,127 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// IdempotencyKeyHeader carries the idempotency key of a grain request, the same for all the retries of the request
+const IdempotencyKeyHeader = "cluster-idempotency-key"
+
+// WithIdempotencyKey sends the key with the request and its retries, so that a grain deduplicating requests
+// processes it once and answers the retries with the response it cached
+func WithIdempotencyKey(key string) GrainCallOption {
+	return func(config *GrainCallConfig) {
+		config.IdempotencyKey = key
+	}
+}
+
+// WithDedupWindow makes the grains of a kind remember their responses to requests with an idempotency key
+// for the window, and answer a repeated key with the remembered response instead of processing it again.
+// It is opt-in, as the grains keep their responses in memory for the window:
+//
+//	kind := shared.NewCalculatorKind(factory, timeout, cluster.WithDedupWindow(time.Minute))
+//
+// Transient error responses are not remembered, so the retries of the request process it again.
+func WithDedupWindow(window time.Duration) actor.PropsOption {
+	cache := newDedupCache(window)
+
+	return func(props *actor.Props) {
+		actor.WithReceiverMiddleware(cache.receiverMiddleware)(props)
+		actor.WithSenderMiddleware(cache.senderMiddleware)(props)
+	}
+}
+
+type dedupEntry struct {
+	response interface{}
+	expires  time.Time
+}
+
+// dedupCache keeps the responses of the grains of a kind by grain and idempotency key
+type dedupCache struct {
+	window    time.Duration
+	mutex     sync.Mutex
+	entries   map[string]dedupEntry
+	lastSweep time.Time
+}
+
+func newDedupCache(window time.Duration) *dedupCache {
+	return &dedupCache{
+		window:    window,
+		entries:   map[string]dedupEntry{},
+		lastSweep: time.Now(),
+	}
+}
+
+func dedupKey(self *actor.PID, header actor.ReadonlyMessageHeader) string {
+	if header == nil {
+		return ""
+	}
+
+	key := header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return ""
+	}
+
+	return self.Id + "/" + key
+}
+
+func (d *dedupCache) get(key string) (interface{}, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	entry, ok := d.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+
+	return entry.response, true
+}
+
+func (d *dedupCache) set(key string, response interface{}) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(d.lastSweep) > d.window {
+		for k, entry := range d.entries {
+			if now.After(entry.expires) {
+				delete(d.entries, k)
+			}
+		}
+		d.lastSweep = now
+	}
+
+	d.entries[key] = dedupEntry{response: response, expires: now.Add(d.window)}
+}
+
+// receiverMiddleware answers a repeated request with the remembered response
+func (d *dedupCache) receiverMiddleware(next actor.ReceiverFunc) actor.ReceiverFunc {
+	return func(c actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+		if key := dedupKey(c.Self(), envelope.Header); key != "" && envelope.Sender != nil {
+			if response, ok := d.get(key); ok {
+				c.ActorSystem().Root.Send(envelope.Sender, response)
+				return
+			}
+		}
+
+		next(c, envelope)
+	}
+}
+
+// senderMiddleware remembers the response to a request with an idempotency key
+func (d *dedupCache) senderMiddleware(next actor.SenderFunc) actor.SenderFunc {
+	return func(c actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		if sender := c.Sender(); sender != nil && sender.Equal(target) {
+			if key := dedupKey(c.Self(), c.MessageHeader()); key != "" {
+				if err, isErr := envelope.Message.(*GrainErrorResponse); !isErr || !IsTransient(err) {
+					d.set(key, envelope.Message)
+				}
+			}
+		}
+
+		next(c, target, envelope)
+	}
+}
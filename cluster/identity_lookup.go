@@ -15,21 +15,30 @@ type IdentityLookup interface {
 	Shutdown()
 }
 
-// StorageLookup contains
+// StorageLookup is the storage backing an IdentityStorageLookup, it keeps track of spawn locks and
+// of the activation of each ClusterIdentity so that at most one activation exists in the cluster
 type StorageLookup interface {
-	TryGetExistingActivation(clusterIdentity *ClusterIdentity) *StoredActivation
+	// TryGetExistingActivation returns the stored activation, or nil if there is none
+	TryGetExistingActivation(clusterIdentity *ClusterIdentity) (*StoredActivation, error)
 
-	TryAcquireLock(clusterIdentity *ClusterIdentity) *SpawnLock
+	// TryAcquireLock takes the spawn lock for the identity, it returns nil if the lock is held by someone else
+	TryAcquireLock(clusterIdentity *ClusterIdentity) (*SpawnLock, error)
 
-	WaitForActivation(clusterIdentity *ClusterIdentity) *StoredActivation
+	// WaitForActivation waits for the holder of the spawn lock to store the activation,
+	// it returns nil if the lock was released without an activation or the wait timed out
+	WaitForActivation(clusterIdentity *ClusterIdentity) (*StoredActivation, error)
 
-	RemoveLock(spawnLock SpawnLock)
+	// RemoveLock releases the spawn lock if no activation was stored with it
+	RemoveLock(spawnLock *SpawnLock) error
 
-	StoreActivation(memberID string, spawnLock *SpawnLock, pid *actor.PID)
+	// StoreActivation stores the activation spawned under the spawn lock, it fails if the lock is no longer held
+	StoreActivation(memberID string, spawnLock *SpawnLock, pid *actor.PID) error
 
-	RemoveActivation(pid *SpawnLock)
+	// RemoveActivation removes the activation of the identity if it is still the given PID
+	RemoveActivation(clusterIdentity *ClusterIdentity, pid *actor.PID) error
 
-	RemoveMemberId(memberID string)
+	// RemoveMemberId removes all activations stored by the member
+	RemoveMemberId(memberID string) error
 }
 
 // SpawnLock contains
@@ -38,7 +47,8 @@ type SpawnLock struct {
 	ClusterIdentity *ClusterIdentity
 }
 
-func newSpawnLock(lockID string, clusterIdentity *ClusterIdentity) *SpawnLock {
+// NewSpawnLock creates a SpawnLock with the given lock id
+func NewSpawnLock(lockID string, clusterIdentity *ClusterIdentity) *SpawnLock {
 	this := &SpawnLock{
 		LockID:          lockID,
 		ClusterIdentity: clusterIdentity,
@@ -49,11 +59,12 @@ func newSpawnLock(lockID string, clusterIdentity *ClusterIdentity) *SpawnLock {
 
 // StoredActivation contains
 type StoredActivation struct {
-	Pid      string
+	Pid      *actor.PID
 	MemberID string
 }
 
-func newStoredActivation(pid string, memberID string) *StoredActivation {
+// NewStoredActivation creates a StoredActivation of the given PID on the given member
+func NewStoredActivation(pid *actor.PID, memberID string) *StoredActivation {
 	this := &StoredActivation{
 		Pid:      pid,
 		MemberID: memberID,
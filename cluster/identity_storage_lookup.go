@@ -1,17 +1,22 @@
 package cluster
 
 import (
-	"time"
+	"log/slog"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/eventstream"
+	"github.com/asynkron/protoactor-go/router"
 )
 
 const (
 	placementActorName           = "placement-activator"
 	pidClusterIdentityStartIndex = len(placementActorName) + 1
+	identityStorageRouterName    = "identity-storage-router"
+	identityStorageWorkerCount   = 50
 )
 
-// IdentityStorageLookup contains
+// IdentityStorageLookup is an IdentityLookup which keeps the activations in a shared StorageLookup.
+// Spawning an identity takes a spawn lock in the storage, so a grain stays a singleton regardless of topology changes.
 type IdentityStorageLookup struct {
 	Storage        StorageLookup
 	cluster        *Cluster
@@ -20,9 +25,11 @@ type IdentityStorageLookup struct {
 	system         *actor.ActorSystem
 	router         *actor.PID
 	memberID       string
+	topologySub    *eventstream.Subscription
 }
 
-func newIdentityStorageLookup(storage StorageLookup) *IdentityStorageLookup {
+// NewIdentityStorageLookup creates an IdentityLookup backed by the given storage
+func NewIdentityStorageLookup(storage StorageLookup) *IdentityStorageLookup {
 	this := &IdentityStorageLookup{
 		Storage: storage,
 	}
@@ -30,8 +37,10 @@ func newIdentityStorageLookup(storage StorageLookup) *IdentityStorageLookup {
 }
 
 // RemoveMember from identity storage
-func (i *IdentityStorageLookup) RemoveMember(memberID string) {
-	i.Storage.RemoveMemberId(memberID)
+func (id *IdentityStorageLookup) RemoveMember(memberID string) {
+	if err := id.Storage.RemoveMemberId(memberID); err != nil {
+		id.cluster.Logger().Error("Failed to remove member activations from identity storage", slog.String("member", memberID), slog.Any("error", err))
+	}
 }
 
 // RemotePlacementActor returns the PID of the remote placement actor
@@ -46,20 +55,72 @@ func RemotePlacementActor(address string) *actor.PID {
 // Get returns a PID for a given ClusterIdentity
 func (id *IdentityStorageLookup) Get(clusterIdentity *ClusterIdentity) *actor.PID {
 	msg := newGetPid(clusterIdentity)
-	timeout := 5 * time.Second
+	timeout := id.cluster.Config.TimeoutTime
 
-	res, _ := id.system.Root.RequestFuture(id.router, msg, timeout).Result()
-	response := res.(*actor.Future)
+	res, err := id.system.Root.RequestFuture(id.router, msg, timeout).Result()
+	if err != nil {
+		id.cluster.Logger().Error("Failed to get PID from identity storage", slog.String("identity", clusterIdentity.ToShortString()), slog.Any("error", err))
+		return nil
+	}
 
-	return response.PID()
+	response, ok := res.(*PidResult)
+	if !ok {
+		return nil
+	}
+
+	return response.Pid
+}
+
+// RemovePid removes the activation from the storage and the PID cache
+func (id *IdentityStorageLookup) RemovePid(clusterIdentity *ClusterIdentity, pid *actor.PID) {
+	id.cluster.PidCache.RemoveByValue(clusterIdentity.Identity, clusterIdentity.Kind, pid)
+
+	if err := id.Storage.RemoveActivation(clusterIdentity, pid); err != nil {
+		id.cluster.Logger().Error("Failed to remove activation from identity storage", slog.String("identity", clusterIdentity.ToShortString()), slog.Any("error", err))
+	}
 }
 
 func (id *IdentityStorageLookup) Setup(cluster *Cluster, kinds []string, isClient bool) {
 	id.cluster = cluster
 	id.system = cluster.ActorSystem
 	id.memberID = cluster.ActorSystem.ID
+	id.isClient = isClient
 
-	// workerProps := actor.PropsFromProducer(func() actor.Actor { return newIdentityStorageWorker(identity) })
+	if !isClient {
+		placementProps := actor.PropsFromProducer(func() actor.Actor { return newIdentityStoragePlacementActor(id) })
+		id.placementActor, _ = id.system.Root.SpawnNamed(placementProps, placementActorName)
+	}
 
-	// routerProps := identity.system.Root.(workerProps, 50);
+	workerProps := router.NewRoundRobinPool(identityStorageWorkerCount,
+		actor.WithProducer(func() actor.Actor { return newIdentityStorageWorker(id) }))
+	id.router, _ = id.system.Root.SpawnNamed(workerProps, identityStorageRouterName)
+
+	// members which left the cluster no longer host their activations
+	id.topologySub = id.system.EventStream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(*ClusterTopology); ok {
+			for _, member := range topology.Left {
+				id.RemoveMember(member.Id)
+			}
+		}
+	})
+}
+
+func (id *IdentityStorageLookup) Shutdown() {
+	if id.topologySub != nil {
+		id.system.EventStream.Unsubscribe(id.topologySub)
+		id.topologySub = nil
+	}
+
+	if id.placementActor != nil {
+		if err := id.system.Root.PoisonFuture(id.placementActor).Wait(); err != nil {
+			id.cluster.Logger().Error("Failed to shutdown identity storage placement actor", slog.Any("error", err))
+		}
+		id.placementActor = nil
+		id.RemoveMember(id.memberID)
+	}
+
+	if id.router != nil {
+		id.system.Root.Stop(id.router)
+		id.router = nil
+	}
 }
@@ -0,0 +1,90 @@
+package cluster
+
+import (
+	"log/slog"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// identityStoragePlacementActor spawns the activations requested by an IdentityStorageLookup and
+// records them in the storage under the spawn lock taken by the requester
+type identityStoragePlacementActor struct {
+	cluster *Cluster
+	lookup  *IdentityStorageLookup
+	actors  map[string]*Activation
+}
+
+func newIdentityStoragePlacementActor(lookup *IdentityStorageLookup) *identityStoragePlacementActor {
+	return &identityStoragePlacementActor{
+		cluster: lookup.cluster,
+		lookup:  lookup,
+		actors:  map[string]*Activation{},
+	}
+}
+
+func (p *identityStoragePlacementActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Stopping:
+		p.onStopping(ctx)
+	case *actor.Terminated:
+		p.onTerminated(msg)
+	case *ActivationRequest:
+		p.onActivationRequest(msg, ctx)
+	}
+}
+
+func (p *identityStoragePlacementActor) onActivationRequest(msg *ActivationRequest, ctx actor.Context) {
+	key := msg.ClusterIdentity.AsKey()
+	if activation, found := p.actors[key]; found {
+		ctx.Respond(&ActivationResponse{Pid: activation.Pid})
+		return
+	}
+
+	clusterKind := p.cluster.GetClusterKind(msg.ClusterIdentity.Kind)
+	if clusterKind == nil {
+		ctx.Logger().Error("Unknown cluster kind", slog.String("kind", msg.ClusterIdentity.Kind))
+		ctx.Respond(&ActivationResponse{Failed: true})
+		return
+	}
+
+	props := WithClusterIdentity(clusterKind.Props, msg.ClusterIdentity)
+	pid := ctx.SpawnPrefix(props, msg.ClusterIdentity.Identity)
+
+	spawnLock := NewSpawnLock(msg.RequestId, msg.ClusterIdentity)
+	if err := p.lookup.Storage.StoreActivation(p.lookup.memberID, spawnLock, pid); err != nil {
+		// the lock was lost, whoever holds it now owns the identity
+		ctx.Logger().Error("Failed to store activation", slog.String("identity", msg.ClusterIdentity.ToShortString()), slog.Any("error", err))
+		ctx.Stop(pid)
+		ctx.Respond(&ActivationResponse{Failed: true})
+		return
+	}
+
+	p.actors[key] = &Activation{Pid: pid, ClusterIdentity: msg.ClusterIdentity}
+	ctx.Respond(&ActivationResponse{Pid: pid})
+}
+
+func (p *identityStoragePlacementActor) onTerminated(msg *actor.Terminated) {
+	for key, activation := range p.actors {
+		if activation.Pid.Equal(msg.Who) {
+			delete(p.actors, key)
+			p.lookup.RemovePid(activation.ClusterIdentity, activation.Pid)
+			return
+		}
+	}
+}
+
+func (p *identityStoragePlacementActor) onStopping(ctx actor.Context) {
+	futures := make(map[string]*actor.Future, len(p.actors))
+	for key, activation := range p.actors {
+		futures[key] = ctx.PoisonFuture(activation.Pid)
+	}
+
+	for key, future := range futures {
+		if err := future.Wait(); err != nil {
+			ctx.Logger().Error("Failed to poison actor", slog.String("identity", key), slog.Any("error", err))
+		}
+
+		activation := p.actors[key]
+		p.lookup.RemovePid(activation.ClusterIdentity, activation.Pid)
+	}
+}
@@ -1,7 +1,8 @@
 package cluster
 
 import (
-	"log"
+	"fmt"
+	"log/slog"
 
 	"github.com/asynkron/protoactor-go/actor"
 )
@@ -23,25 +24,103 @@ func newIdentityStorageWorker(storageLookup *IdentityStorageLookup) *IdentitySto
 
 // Receive func
 func (ids *IdentityStorageWorker) Receive(c actor.Context) {
-	m := c.Message()
-	getPid, ok := m.(GetPid)
-
+	getPid, ok := c.Message().(*GetPid)
 	if !ok {
 		return
 	}
 
 	if c.Sender() == nil {
-		log.Println("No sender in GetPid request")
+		c.Logger().Error("No sender in GetPid request")
 		return
 	}
 
 	existing, _ := ids.cluster.PidCache.Get(getPid.ClusterIdentity.Identity, getPid.ClusterIdentity.Kind)
-
 	if existing != nil {
-		log.Printf("Found %s in pidcache", m.(GetPid).ClusterIdentity.ToShortString())
 		c.Respond(newPidResult(existing))
+		return
+	}
+
+	pid, err := ids.getWithGlobalLock(getPid.ClusterIdentity)
+	if err != nil {
+		c.Logger().Error("Failed to get PID from identity storage", slog.String("identity", getPid.ClusterIdentity.ToShortString()), slog.Any("error", err))
+	}
+	if pid != nil {
+		ids.cluster.PidCache.Set(getPid.ClusterIdentity.Identity, getPid.ClusterIdentity.Kind, pid)
+	}
+
+	c.Respond(newPidResult(pid))
+}
+
+func (ids *IdentityStorageWorker) getWithGlobalLock(clusterIdentity *ClusterIdentity) (*actor.PID, error) {
+	activation, err := ids.storage.TryGetExistingActivation(clusterIdentity)
+	if err != nil {
+		return nil, err
+	}
+	if pid, ok := ids.validActivation(clusterIdentity, activation); ok {
+		return pid, nil
+	}
+
+	activator := ids.cluster.MemberList.GetActivatorMember(clusterIdentity.Kind, ids.cluster.ActorSystem.Address())
+	if activator == "" {
+		return nil, nil
+	}
+
+	spawnLock, err := ids.storage.TryAcquireLock(clusterIdentity)
+	if err != nil {
+		return nil, err
+	}
+	if spawnLock == nil {
+		// someone else is spawning the identity
+		activation, err = ids.storage.WaitForActivation(clusterIdentity)
+		if err != nil {
+			return nil, err
+		}
+		pid, _ := ids.validActivation(clusterIdentity, activation)
+		return pid, nil
+	}
+
+	return ids.spawnActivation(activator, spawnLock)
+}
+
+// validActivation returns the PID of the activation unless it is hosted by a member which is no longer in the cluster.
+// Stale activations are removed so that the identity can be spawned again.
+func (ids *IdentityStorageWorker) validActivation(clusterIdentity *ClusterIdentity, activation *StoredActivation) (*actor.PID, bool) {
+	if activation == nil {
+		return nil, false
+	}
+
+	memberList := ids.cluster.MemberList
+	// until this member has seen itself in the topology the member list is not to be trusted
+	if ids.lookup.isClient || !memberList.ContainsMemberID(ids.lookup.memberID) || memberList.ContainsMemberID(activation.MemberID) {
+		return activation.Pid, true
+	}
+
+	ids.cluster.Logger().Info("Removing activation of a member which left the cluster",
+		slog.String("identity", clusterIdentity.ToShortString()), slog.String("member", activation.MemberID))
+	if err := ids.storage.RemoveActivation(clusterIdentity, activation.Pid); err != nil {
+		ids.cluster.Logger().Error("Failed to remove stale activation", slog.String("identity", clusterIdentity.ToShortString()), slog.Any("error", err))
+	}
+
+	return nil, false
+}
+
+func (ids *IdentityStorageWorker) spawnActivation(activator string, spawnLock *SpawnLock) (*actor.PID, error) {
+	request := &ActivationRequest{
+		ClusterIdentity: spawnLock.ClusterIdentity,
+		RequestId:       spawnLock.LockID,
+	}
+
+	res, err := ids.cluster.ActorSystem.Root.RequestFuture(RemotePlacementActor(activator), request, ids.cluster.Config.TimeoutTime).Result()
+	if err == nil {
+		if response, ok := res.(*ActivationResponse); ok && !response.Failed && response.Pid != nil {
+			return response.Pid, nil
+		}
+		err = fmt.Errorf("activation of %s failed on %s", spawnLock.ClusterIdentity.ToShortString(), activator)
+	}
+
+	if lockErr := ids.storage.RemoveLock(spawnLock); lockErr != nil {
+		ids.cluster.Logger().Error("Failed to remove spawn lock", slog.String("identity", spawnLock.ClusterIdentity.ToShortString()), slog.Any("error", lockErr))
 	}
 
-	return
-	// continue
+	return nil, err
 }
@@ -23,7 +23,7 @@ type Manager struct {
 func newPartitionManager(c *clustering.Cluster) *Manager {
 	return &Manager{
 		cluster: c,
-		rdv:     clustering.NewRendezvous(),
+		rdv:     clustering.NewRendezvous().WithPlacement(c.Placement),
 	}
 }
 
@@ -66,8 +66,8 @@ func (pm *Manager) onClusterTopology(tplg *clustering.ClusterTopology) {
 		pm.cluster.Logger().Info("Got member", slog.Any("member", m))
 	}
 
-	pm.rdv = clustering.NewRendezvous()
-	pm.rdv.UpdateMembers(tplg.Members)
+	pm.rdv = clustering.NewRendezvous().WithPlacement(pm.cluster.Placement)
+	pm.rdv.UpdateMembers(pm.cluster.MemberList.Placeable(tplg.Members))
 	pm.cluster.ActorSystem.Root.Send(pm.placementActor, tplg)
 }
 
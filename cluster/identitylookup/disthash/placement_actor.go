@@ -120,8 +120,9 @@ func (p *placementActor) pidToMeta(pid *actor.PID) (bool, *string, *GrainMeta) {
 }
 
 func (p *placementActor) onClusterTopology(msg *clustering.ClusterTopology, ctx actor.Context) {
-	rdv := clustering.NewRendezvous()
-	rdv.UpdateMembers(msg.Members)
+	rdv := clustering.NewRendezvous().WithPlacement(p.cluster.Placement)
+	// grains move away from the members which are not up, such as this member when it is leaving
+	rdv.UpdateMembers(p.cluster.MemberList.Placeable(msg.Members))
 	myAddress := p.cluster.ActorSystem.Address()
 	for identity, meta := range p.actors {
 		ownerAddress := rdv.GetByIdentity(identity)
@@ -0,0 +1,60 @@
+package partition
+
+import (
+	"time"
+)
+
+type Config struct {
+	// RequestTimeout is the timeout of activation requests sent to the identity owner. Default: 5s
+	RequestTimeout time.Duration
+	// HandoverChunkSize is the maximum number of activations sent in a single handover chunk. Default: 1000
+	HandoverChunkSize int
+	// HandoverTimeout is the time an identity owner waits for all members to hand over their activations. Default: 10s
+	HandoverTimeout time.Duration
+	// ConsensusTimeout is the time an identity owner waits for topology consensus before starting a handover. Default: 5s
+	ConsensusTimeout time.Duration
+}
+
+type Option func(config *Config)
+
+// WithRequestTimeout sets the timeout of activation requests. Default: 5s
+func WithRequestTimeout(requestTimeout time.Duration) Option {
+	return func(config *Config) {
+		config.RequestTimeout = requestTimeout
+	}
+}
+
+// WithHandoverChunkSize sets the maximum number of activations per handover chunk. Default: 1000
+func WithHandoverChunkSize(chunkSize int) Option {
+	return func(config *Config) {
+		config.HandoverChunkSize = chunkSize
+	}
+}
+
+// WithHandoverTimeout sets the time to wait for all members to hand over their activations. Default: 10s
+func WithHandoverTimeout(handoverTimeout time.Duration) Option {
+	return func(config *Config) {
+		config.HandoverTimeout = handoverTimeout
+	}
+}
+
+// WithConsensusTimeout sets the time to wait for topology consensus before a handover. Default: 5s
+func WithConsensusTimeout(consensusTimeout time.Duration) Option {
+	return func(config *Config) {
+		config.ConsensusTimeout = consensusTimeout
+	}
+}
+
+func newConfig(opts ...Option) *Config {
+	config := &Config{
+		RequestTimeout:    5 * time.Second,
+		HandoverChunkSize: 1000,
+		HandoverTimeout:   10 * time.Second,
+		ConsensusTimeout:  5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
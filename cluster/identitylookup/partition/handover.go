@@ -0,0 +1,100 @@
+package partition
+
+import (
+	"github.com/asynkron/protoactor-go/actor"
+	clustering "github.com/asynkron/protoactor-go/cluster"
+)
+
+// handoverChunks splits the activations into handover chunks of at most chunkSize activations.
+// There is always at least one chunk and only the last one is final.
+func handoverChunks(activations []*clustering.Activation, chunkSize int, topologyHash uint64, skipped int) []*clustering.IdentityHandover {
+	if chunkSize <= 0 {
+		chunkSize = len(activations)
+	}
+
+	chunks := make([]*clustering.IdentityHandover, 0, len(activations)/max(chunkSize, 1)+1)
+	sent := 0
+	for {
+		end := min(sent+chunkSize, len(activations))
+		chunk := &clustering.IdentityHandover{
+			Actors:       activations[sent:end],
+			ChunkId:      int32(len(chunks)),
+			TopologyHash: topologyHash,
+			Skipped:      int32(skipped),
+			Sent:         int32(end),
+		}
+		chunks = append(chunks, chunk)
+		sent = end
+
+		if sent >= len(activations) {
+			chunk.Final = true
+			return chunks
+		}
+	}
+}
+
+// packHandover converts a handover chunk of activations living on address into its compact wire format
+func packHandover(address string, handover *clustering.IdentityHandover) *clustering.RemoteIdentityHandover {
+	packed := &clustering.PackedActivations{Address: address}
+	kinds := map[string]*clustering.PackedActivations_Kind{}
+
+	for _, activation := range handover.Actors {
+		kind, ok := kinds[activation.ClusterIdentity.Kind]
+		if !ok {
+			kind = &clustering.PackedActivations_Kind{Name: activation.ClusterIdentity.Kind}
+			kinds[kind.Name] = kind
+			packed.Actors = append(packed.Actors, kind)
+		}
+		kind.Activations = append(kind.Activations, &clustering.PackedActivations_Activation{
+			Identity:     activation.ClusterIdentity.Identity,
+			ActivationId: activation.Pid.Id,
+		})
+	}
+
+	return &clustering.RemoteIdentityHandover{
+		Actors:       packed,
+		ChunkId:      handover.ChunkId,
+		Final:        handover.Final,
+		TopologyHash: handover.TopologyHash,
+		Skipped:      handover.Skipped,
+		Sent:         handover.Sent,
+	}
+}
+
+// unpackHandover converts a handover chunk received from a remote member back into activations
+func unpackHandover(remote *clustering.RemoteIdentityHandover) *clustering.IdentityHandover {
+	handover := &clustering.IdentityHandover{
+		ChunkId:      remote.ChunkId,
+		Final:        remote.Final,
+		TopologyHash: remote.TopologyHash,
+		Skipped:      remote.Skipped,
+		Sent:         remote.Sent,
+	}
+
+	if remote.Actors == nil {
+		return handover
+	}
+
+	for _, kind := range remote.Actors.Actors {
+		for _, activation := range kind.Activations {
+			handover.Actors = append(handover.Actors, &clustering.Activation{
+				Pid:             actor.NewPID(remote.Actors.Address, activation.ActivationId),
+				ClusterIdentity: clustering.NewClusterIdentity(activation.Identity, kind.Name),
+			})
+		}
+	}
+
+	return handover
+}
+
+// handoverTopology converts a cluster topology into the topology sent with a handover request
+func handoverTopology(topology *clustering.ClusterTopology) *clustering.IdentityHandoverRequest_Topology {
+	if topology == nil {
+		return nil
+	}
+
+	return &clustering.IdentityHandoverRequest_Topology{
+		TopologyHash: topology.TopologyHash,
+		Members:      topology.Members,
+	}
+}
@@ -0,0 +1,79 @@
+package partition
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asynkron/protoactor-go/actor"
+	clustering "github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func activations(n int) []*clustering.Activation {
+	res := make([]*clustering.Activation, 0, n)
+	for i := 0; i < n; i++ {
+		kind := "a"
+		if i%2 == 1 {
+			kind = "b"
+		}
+		res = append(res, &clustering.Activation{
+			Pid:             actor.NewPID("127.0.0.1:1", fmt.Sprintf("partition-activator/%d$1", i)),
+			ClusterIdentity: clustering.NewClusterIdentity(fmt.Sprintf("%d", i), kind),
+		})
+	}
+	return res
+}
+
+func TestHandoverChunks(t *testing.T) {
+	chunks := handoverChunks(activations(5), 2, 42, 3)
+	require.Len(t, chunks, 3)
+
+	for i, chunk := range chunks {
+		assert.Equal(t, int32(i), chunk.ChunkId)
+		assert.Equal(t, uint64(42), chunk.TopologyHash)
+		assert.Equal(t, int32(3), chunk.Skipped)
+		assert.Equal(t, i == 2, chunk.Final)
+	}
+	assert.Len(t, chunks[2].Actors, 1)
+	assert.Equal(t, int32(5), chunks[2].Sent)
+}
+
+func TestHandoverChunks_Empty(t *testing.T) {
+	chunks := handoverChunks(nil, 2, 42, 0)
+	require.Len(t, chunks, 1)
+	assert.True(t, chunks[0].Final)
+	assert.Empty(t, chunks[0].Actors)
+}
+
+func TestPackHandover_RoundTrip(t *testing.T) {
+	chunk := handoverChunks(activations(4), 10, 42, 0)[0]
+
+	remote := packHandover("127.0.0.1:1", chunk)
+	assert.Len(t, remote.Actors.Actors, 2, "activations are grouped by kind")
+
+	unpacked := unpackHandover(remote)
+	assert.True(t, unpacked.Final)
+	assert.Equal(t, uint64(42), unpacked.TopologyHash)
+	require.Len(t, unpacked.Actors, 4)
+
+	expected := map[string]*actor.PID{}
+	for _, a := range chunk.Actors {
+		expected[a.ClusterIdentity.AsKey()] = a.Pid
+	}
+	for _, a := range unpacked.Actors {
+		assert.True(t, expected[a.ClusterIdentity.AsKey()].Equal(a.Pid))
+	}
+}
+
+func TestPlacementActor_DropsHandoversOfEarlierTopologies(t *testing.T) {
+	p := &placementActor{handovers: map[string]*outgoingHandover{
+		"stale":   {chunks: handoverChunks(activations(2), 1, 41, 0), next: 1},
+		"current": {chunks: handoverChunks(activations(2), 1, 42, 0), next: 1},
+	}}
+
+	p.dropStaleHandovers(42)
+
+	assert.NotContains(t, p.handovers, "stale")
+	assert.Contains(t, p.handovers, "current")
+}
@@ -0,0 +1,325 @@
+package partition
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	clustering "github.com/asynkron/protoactor-go/cluster"
+)
+
+// topologyConsensus is sent by the consensus watcher once the members agree on the topology of the handover,
+// or when they did not agree on it in time
+type topologyConsensus struct {
+	topologyHash uint64
+	reached      bool
+}
+
+// handoverTimeout is scheduled when a handover starts and ends it if some members never answered
+type handoverTimeout struct {
+	topologyHash uint64
+}
+
+type deferredRequest struct {
+	request *clustering.ActivationRequest
+	sender  *actor.PID
+}
+
+// handoverState tracks an ongoing handover, the identity actor does not answer activation requests
+// until every member has sent its final chunk for the topology
+type handoverState struct {
+	topologyHash uint64
+	started      bool
+	pending      map[string]struct{}
+}
+
+// identityActor owns the identities assigned to this member and knows where their activations live
+type identityActor struct {
+	cluster  *clustering.Cluster
+	config   *Config
+	address  string
+	lookup   map[string]*clustering.Activation
+	inflight map[string]*actor.Future
+	rdv      *clustering.Rendezvous
+	topology *clustering.ClusterTopology
+	previous *clustering.ClusterTopology // last topology with a completed handover
+	handover *handoverState
+	deferred []deferredRequest
+	// stopWatch stops the consensus watcher of the pending handover
+	stopWatch context.CancelFunc
+}
+
+func newIdentityActor(c *clustering.Cluster, config *Config) *identityActor {
+	return &identityActor{
+		cluster:  c,
+		config:   config,
+		address:  c.ActorSystem.Address(),
+		lookup:   map[string]*clustering.Activation{},
+		inflight: map[string]*actor.Future{},
+		rdv:      clustering.NewRendezvous(),
+	}
+}
+
+func (p *identityActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Stopped:
+		p.stopConsensusWatch()
+	case *clustering.ActivationRequest:
+		p.onActivationRequest(msg, ctx.Sender(), ctx)
+	case *clustering.ActivationTerminated:
+		p.onActivationTerminated(msg)
+	case *clustering.ClusterTopology:
+		p.onClusterTopology(msg, ctx)
+	case *topologyConsensus:
+		p.onTopologyConsensus(msg, ctx)
+	case *clustering.RemoteIdentityHandover:
+		p.onRemoteIdentityHandover(msg, ctx)
+	case *handoverTimeout:
+		p.onHandoverTimeout(msg, ctx)
+	}
+}
+
+func (p *identityActor) onClusterTopology(msg *clustering.ClusterTopology, ctx actor.Context) {
+	p.topology = msg
+	p.rdv = clustering.NewRendezvous()
+	p.rdv.UpdateMembers(msg.Members)
+
+	left := make(map[string]struct{}, len(msg.Left))
+	for _, member := range msg.Left {
+		left[member.Address()] = struct{}{}
+	}
+
+	// forget activations on members which left and identities which now belong to someone else,
+	// the placement actors hand the latter over to their new owners
+	for key, activation := range p.lookup {
+		_, gone := left[activation.Pid.Address]
+		if gone || p.rdv.GetByClusterIdentity(activation.ClusterIdentity) != p.address {
+			delete(p.lookup, key)
+		}
+	}
+
+	p.handover = &handoverState{topologyHash: msg.TopologyHash}
+	p.watchConsensus(msg.TopologyHash, ctx)
+}
+
+// watchConsensus polls the topology consensus until the members agree on the topology or the consensus timeout
+// expires, and tells the identity actor either way
+func (p *identityActor) watchConsensus(topologyHash uint64, ctx actor.Context) {
+	p.stopConsensusWatch()
+	watchCtx, cancel := context.WithTimeout(context.Background(), p.config.ConsensusTimeout)
+	p.stopWatch = cancel
+
+	self := ctx.Self()
+	system := ctx.ActorSystem()
+	memberList := p.cluster.MemberList
+	interval := p.cluster.Config.GossipInterval
+	go func() {
+		defer cancel()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if hash, ok := memberList.TopologyConsensus(watchCtx); ok && hash == topologyHash {
+				system.Root.Send(self, &topologyConsensus{topologyHash: topologyHash, reached: true})
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-watchCtx.Done():
+				if watchCtx.Err() == context.DeadlineExceeded {
+					system.Root.Send(self, &topologyConsensus{topologyHash: topologyHash})
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (p *identityActor) stopConsensusWatch() {
+	if p.stopWatch != nil {
+		p.stopWatch()
+		p.stopWatch = nil
+	}
+}
+
+func (p *identityActor) onTopologyConsensus(msg *topologyConsensus, ctx actor.Context) {
+	// the watcher of a previous topology may report after the topology moved on
+	if p.handover == nil || p.handover.started || msg.topologyHash != p.handover.topologyHash {
+		return
+	}
+
+	p.stopWatch = nil
+	if !msg.reached {
+		ctx.Logger().Warn("No topology consensus, starting handover anyway", slog.Uint64("topology-hash", p.handover.topologyHash))
+	}
+
+	p.startHandover(ctx)
+}
+
+func (p *identityActor) startHandover(ctx actor.Context) {
+	request := &clustering.IdentityHandoverRequest{
+		CurrentTopology: handoverTopology(p.topology),
+		Address:         p.address,
+		DeltaTopology:   handoverTopology(p.previous),
+	}
+
+	p.handover.started = true
+	p.handover.pending = make(map[string]struct{}, len(p.topology.Members))
+	for _, member := range p.topology.Members {
+		p.handover.pending[member.Address()] = struct{}{}
+		ctx.Request(placementActorPID(member.Address()), request)
+	}
+
+	ctx.Logger().Info("Started identity handover", slog.Uint64("topology-hash", p.handover.topologyHash), slog.Int("members", len(p.handover.pending)))
+
+	self := ctx.Self()
+	system := ctx.ActorSystem()
+	timeout := &handoverTimeout{topologyHash: p.handover.topologyHash}
+	time.AfterFunc(p.config.HandoverTimeout, func() {
+		system.Root.Send(self, timeout)
+	})
+}
+
+func (p *identityActor) onRemoteIdentityHandover(msg *clustering.RemoteIdentityHandover, ctx actor.Context) {
+	ack := &clustering.IdentityHandoverAck{
+		ChunkId:      msg.ChunkId,
+		TopologyHash: msg.TopologyHash,
+	}
+
+	if p.handover == nil || !p.handover.started || p.handover.topologyHash != msg.TopologyHash {
+		ack.ProcessingState = clustering.IdentityHandoverAck_incorrect_topology
+		ctx.Request(ctx.Sender(), ack)
+		return
+	}
+
+	handover := unpackHandover(msg)
+	for _, activation := range handover.Actors {
+		if p.rdv.GetByClusterIdentity(activation.ClusterIdentity) != p.address {
+			continue
+		}
+
+		key := activation.ClusterIdentity.AsKey()
+		if existing, ok := p.lookup[key]; ok && !existing.Pid.Equal(activation.Pid) {
+			// two activations of the same identity, the one already known wins
+			ctx.Logger().Warn("Stopping duplicate activation", slog.String("identity", key), slog.Any("pid", activation.Pid))
+			ctx.Stop(activation.Pid)
+			continue
+		}
+		p.lookup[key] = activation
+	}
+
+	ack.ProcessingState = clustering.IdentityHandoverAck_processed
+	ctx.Request(ctx.Sender(), ack)
+
+	if msg.Final && msg.Actors != nil {
+		delete(p.handover.pending, msg.Actors.Address)
+		if len(p.handover.pending) == 0 {
+			ctx.Logger().Info("Completed identity handover", slog.Uint64("topology-hash", msg.TopologyHash), slog.Int("activations", len(p.lookup)))
+			p.previous = p.topology
+			p.endHandover(ctx)
+		}
+	}
+}
+
+func (p *identityActor) onHandoverTimeout(msg *handoverTimeout, ctx actor.Context) {
+	if p.handover == nil || p.handover.topologyHash != msg.topologyHash {
+		return
+	}
+
+	ctx.Logger().Warn("Identity handover timed out", slog.Uint64("topology-hash", msg.topologyHash), slog.Int("pending", len(p.handover.pending)))
+	// the owned activations may be incomplete, the next handover must not rely on them
+	p.previous = nil
+	p.endHandover(ctx)
+}
+
+func (p *identityActor) endHandover(ctx actor.Context) {
+	p.handover = nil
+
+	deferred := p.deferred
+	p.deferred = nil
+	for _, d := range deferred {
+		p.onActivationRequest(d.request, d.sender, ctx)
+	}
+}
+
+func (p *identityActor) onActivationTerminated(msg *clustering.ActivationTerminated) {
+	key := msg.ClusterIdentity.AsKey()
+	if activation, ok := p.lookup[key]; ok && activation.Pid.Equal(msg.Pid) {
+		delete(p.lookup, key)
+	}
+}
+
+func (p *identityActor) onActivationRequest(msg *clustering.ActivationRequest, sender *actor.PID, ctx actor.Context) {
+	if p.handover != nil {
+		p.deferred = append(p.deferred, deferredRequest{request: msg, sender: sender})
+		return
+	}
+
+	respond := func(response *clustering.ActivationResponse) {
+		if sender != nil {
+			response.TopologyHash = p.topologyHash()
+			ctx.Send(sender, response)
+		}
+	}
+
+	if p.rdv.GetByClusterIdentity(msg.ClusterIdentity) != p.address {
+		// the requester has a different view of the topology, it will retry
+		respond(&clustering.ActivationResponse{Failed: true})
+		return
+	}
+
+	key := msg.ClusterIdentity.AsKey()
+	if activation, ok := p.lookup[key]; ok {
+		respond(&clustering.ActivationResponse{Pid: activation.Pid})
+		return
+	}
+
+	future, ok := p.inflight[key]
+	if !ok {
+		requestAddress := p.address
+		if sender != nil {
+			requestAddress = sender.Address
+		}
+		activator := p.cluster.MemberList.GetActivatorMember(msg.ClusterIdentity.Kind, requestAddress)
+		if activator == "" {
+			respond(&clustering.ActivationResponse{Failed: true})
+			return
+		}
+
+		request := &clustering.ActivationRequest{
+			ClusterIdentity: msg.ClusterIdentity,
+			TopologyHash:    p.topologyHash(),
+		}
+		future = ctx.RequestFuture(placementActorPID(activator), request, p.config.RequestTimeout)
+		p.inflight[key] = future
+	}
+
+	ctx.ReenterAfter(future, func(res interface{}, err error) {
+		// a request arriving after an earlier waiter completed may have registered a new future
+		if p.inflight[key] == future {
+			delete(p.inflight, key)
+		}
+
+		response, ok := res.(*clustering.ActivationResponse)
+		if err != nil || !ok || response.Failed || response.Pid == nil {
+			respond(&clustering.ActivationResponse{Failed: true})
+			return
+		}
+
+		if p.rdv.GetByClusterIdentity(msg.ClusterIdentity) == p.address {
+			p.lookup[key] = &clustering.Activation{Pid: response.Pid, ClusterIdentity: msg.ClusterIdentity}
+		}
+		respond(&clustering.ActivationResponse{Pid: response.Pid})
+	})
+}
+
+func (p *identityActor) topologyHash() uint64 {
+	if p.topology == nil {
+		return 0
+	}
+
+	return p.topology.TopologyHash
+}
@@ -0,0 +1,35 @@
+package partition
+
+import (
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+)
+
+// IdentityLookup assigns every identity to an owner member using rendezvous hashing.
+// The owner keeps track of the activation, which may live on any member, and when the topology
+// changes the activations are handed over to their new owners instead of being stopped.
+type IdentityLookup struct {
+	config           *Config
+	partitionManager *Manager
+}
+
+func (p *IdentityLookup) Get(clusterIdentity *cluster.ClusterIdentity) *actor.PID {
+	return p.partitionManager.Get(clusterIdentity)
+}
+
+func (p *IdentityLookup) RemovePid(clusterIdentity *cluster.ClusterIdentity, pid *actor.PID) {
+	p.partitionManager.RemovePid(clusterIdentity, pid)
+}
+
+func (p *IdentityLookup) Setup(cluster *cluster.Cluster, kinds []string, isClient bool) {
+	p.partitionManager = newPartitionManager(cluster, p.config)
+	p.partitionManager.Start(isClient)
+}
+
+func (p *IdentityLookup) Shutdown() {
+	p.partitionManager.Stop()
+}
+
+func New(opts ...Option) cluster.IdentityLookup {
+	return &IdentityLookup{config: newConfig(opts...)}
+}
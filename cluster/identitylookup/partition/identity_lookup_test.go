@@ -0,0 +1,53 @@
+package partition
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	clustering "github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/cluster/cluster_test_tool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityLookup_HandsOverActivationsOnTopologyChange(t *testing.T) {
+	fixture := cluster_test_tool.NewBaseInMemoryClusterFixture(2,
+		cluster_test_tool.WithGetIdentityLookup(func(clusterName string) clustering.IdentityLookup {
+			return New(WithHandoverChunkSize(2))
+		}),
+		cluster_test_tool.WithGetClusterKinds(func() []*clustering.Kind {
+			return []*clustering.Kind{clustering.NewKind("echo", actor.PropsFromFunc(func(ctx actor.Context) {
+				if msg, ok := ctx.Message().(*actor.PID); ok {
+					ctx.Respond(msg)
+				}
+			}))}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	pids := map[string]*actor.PID{}
+	for i := 0; i < 20; i++ {
+		identity := fmt.Sprintf("grain-%d", i)
+		res, err := members[0].Request(identity, "echo", &actor.PID{Id: "ping"})
+		require.NoError(t, err)
+		assert.Equal(t, "ping", res.(*actor.PID).Id)
+
+		pids[identity] = members[0].Get(identity, "echo")
+		require.NotNil(t, pids[identity])
+	}
+
+	joined := fixture.SpawnNode()
+
+	// every member resolves the same activations after the new owners received them
+	for _, member := range append(members, joined) {
+		for identity, pid := range pids {
+			assert.Eventually(t, func() bool {
+				return pid.Equal(member.Get(identity, "echo"))
+			}, 10*time.Second, 50*time.Millisecond, identity)
+		}
+	}
+}
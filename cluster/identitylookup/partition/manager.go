@@ -0,0 +1,137 @@
+package partition
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/asynkron/protoactor-go/actor"
+	clustering "github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/eventstream"
+)
+
+const (
+	PartitionIdentityActorName  = "partition-identity"
+	PartitionActivatorActorName = "partition-activator"
+)
+
+type Manager struct {
+	cluster        *clustering.Cluster
+	config         *Config
+	topologySub    *eventstream.Subscription
+	identityActor  *actor.PID
+	placementActor *actor.PID
+	mu             sync.RWMutex
+	rdv            *clustering.Rendezvous
+}
+
+func newPartitionManager(c *clustering.Cluster, config *Config) *Manager {
+	return &Manager{
+		cluster: c,
+		config:  config,
+		rdv:     clustering.NewRendezvous(),
+	}
+}
+
+func (pm *Manager) Start(isClient bool) {
+	system := pm.cluster.ActorSystem
+
+	if !isClient {
+		identityProps := actor.PropsFromProducer(func() actor.Actor { return newIdentityActor(pm.cluster, pm.config) })
+		pm.identityActor, _ = system.Root.SpawnNamed(identityProps, PartitionIdentityActorName)
+
+		placementProps := actor.PropsFromProducer(func() actor.Actor { return newPlacementActor(pm.cluster, pm.config) })
+		pm.placementActor, _ = system.Root.SpawnNamed(placementProps, PartitionActivatorActorName)
+		pm.cluster.Logger().Info("Started partition identity and placement actors")
+	}
+
+	pm.topologySub = system.EventStream.
+		Subscribe(func(ev interface{}) {
+			if topology, ok := ev.(*clustering.ClusterTopology); ok {
+				pm.onClusterTopology(topology)
+			}
+		})
+}
+
+func (pm *Manager) Stop() {
+	system := pm.cluster.ActorSystem
+	if pm.topologySub != nil {
+		system.EventStream.Unsubscribe(pm.topologySub)
+		pm.topologySub = nil
+	}
+
+	// stop the activations first, their owners are notified while the identity actors are still running
+	for _, pid := range []*actor.PID{pm.placementActor, pm.identityActor} {
+		if pid == nil {
+			continue
+		}
+		if err := system.Root.PoisonFuture(pid).Wait(); err != nil {
+			pm.cluster.Logger().Error("Failed to shutdown partition actor", slog.Any("pid", pid), slog.Any("error", err))
+		}
+	}
+	pm.placementActor = nil
+	pm.identityActor = nil
+
+	pm.cluster.Logger().Info("Stopped PartitionManager")
+}
+
+func (pm *Manager) onClusterTopology(tplg *clustering.ClusterTopology) {
+	rdv := clustering.NewRendezvous()
+	rdv.UpdateMembers(tplg.Members)
+
+	pm.mu.Lock()
+	pm.rdv = rdv
+	pm.mu.Unlock()
+
+	if pm.identityActor != nil {
+		pm.cluster.ActorSystem.Root.Send(pm.placementActor, tplg)
+		pm.cluster.ActorSystem.Root.Send(pm.identityActor, tplg)
+	}
+}
+
+func (pm *Manager) ownerOf(identity *clustering.ClusterIdentity) string {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	return pm.rdv.GetByClusterIdentity(identity)
+}
+
+func (pm *Manager) Get(identity *clustering.ClusterIdentity) *actor.PID {
+	ownerAddress := pm.ownerOf(identity)
+	if ownerAddress == "" {
+		return nil
+	}
+
+	request := &clustering.ActivationRequest{
+		ClusterIdentity: identity,
+	}
+	res, err := pm.cluster.ActorSystem.Root.RequestFuture(identityActorPID(ownerAddress), request, pm.config.RequestTimeout).Result()
+	if err != nil {
+		return nil
+	}
+	typed, ok := res.(*clustering.ActivationResponse)
+	if !ok || typed.Failed {
+		return nil
+	}
+
+	return typed.Pid
+}
+
+func (pm *Manager) RemovePid(identity *clustering.ClusterIdentity, pid *actor.PID) {
+	ownerAddress := pm.ownerOf(identity)
+	if ownerAddress == "" {
+		return
+	}
+
+	pm.cluster.ActorSystem.Root.Send(identityActorPID(ownerAddress), &clustering.ActivationTerminated{
+		Pid:             pid,
+		ClusterIdentity: identity,
+	})
+}
+
+func identityActorPID(address string) *actor.PID {
+	return actor.NewPID(address, PartitionIdentityActorName)
+}
+
+func placementActorPID(address string) *actor.PID {
+	return actor.NewPID(address, PartitionActivatorActorName)
+}
@@ -0,0 +1,186 @@
+package partition
+
+import (
+	"log/slog"
+
+	"github.com/asynkron/protoactor-go/actor"
+	clustering "github.com/asynkron/protoactor-go/cluster"
+)
+
+// outgoingHandover is a handover to an identity owner, one chunk is in flight until it is acknowledged
+type outgoingHandover struct {
+	target *actor.PID
+	chunks []*clustering.IdentityHandover
+	next   int
+}
+
+// placementActor spawns the activations on this member and hands them over to their owners
+type placementActor struct {
+	cluster   *clustering.Cluster
+	config    *Config
+	address   string
+	actors    map[string]*clustering.Activation
+	rdv       *clustering.Rendezvous
+	handovers map[string]*outgoingHandover
+}
+
+func newPlacementActor(c *clustering.Cluster, config *Config) *placementActor {
+	return &placementActor{
+		cluster:   c,
+		config:    config,
+		address:   c.ActorSystem.Address(),
+		actors:    map[string]*clustering.Activation{},
+		rdv:       clustering.NewRendezvous(),
+		handovers: map[string]*outgoingHandover{},
+	}
+}
+
+func (p *placementActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Stopping:
+		p.onStopping(ctx)
+	case *actor.Terminated:
+		p.onTerminated(msg, ctx)
+	case *clustering.ActivationRequest:
+		p.onActivationRequest(msg, ctx)
+	case *clustering.ClusterTopology:
+		// activations are kept on topology changes, their new owners request them with a handover
+		p.rdv = clustering.NewRendezvous()
+		p.rdv.UpdateMembers(msg.Members)
+		p.dropStaleHandovers(msg.TopologyHash)
+	case *clustering.IdentityHandoverRequest:
+		p.onIdentityHandoverRequest(msg, ctx)
+	case *clustering.IdentityHandoverAck:
+		p.onIdentityHandoverAck(msg, ctx)
+	}
+}
+
+func (p *placementActor) onActivationRequest(msg *clustering.ActivationRequest, ctx actor.Context) {
+	key := msg.ClusterIdentity.AsKey()
+	if activation, found := p.actors[key]; found {
+		ctx.Respond(&clustering.ActivationResponse{Pid: activation.Pid})
+		return
+	}
+
+	clusterKind := p.cluster.GetClusterKind(msg.ClusterIdentity.Kind)
+	if clusterKind == nil {
+		ctx.Logger().Error("Unknown cluster kind", slog.String("kind", msg.ClusterIdentity.Kind))
+		ctx.Respond(&clustering.ActivationResponse{Failed: true})
+		return
+	}
+
+	props := clustering.WithClusterIdentity(clusterKind.Props, msg.ClusterIdentity)
+	pid := ctx.SpawnPrefix(props, msg.ClusterIdentity.Identity)
+
+	p.actors[key] = &clustering.Activation{Pid: pid, ClusterIdentity: msg.ClusterIdentity}
+	ctx.Respond(&clustering.ActivationResponse{Pid: pid})
+}
+
+func (p *placementActor) onTerminated(msg *actor.Terminated, ctx actor.Context) {
+	for key, activation := range p.actors {
+		if !activation.Pid.Equal(msg.Who) {
+			continue
+		}
+
+		delete(p.actors, key)
+		if owner := p.rdv.GetByClusterIdentity(activation.ClusterIdentity); owner != "" {
+			ctx.Send(identityActorPID(owner), &clustering.ActivationTerminated{
+				Pid:             activation.Pid,
+				ClusterIdentity: activation.ClusterIdentity,
+			})
+		}
+		return
+	}
+}
+
+func (p *placementActor) onStopping(ctx actor.Context) {
+	futures := make(map[string]*actor.Future, len(p.actors))
+	for key, activation := range p.actors {
+		futures[key] = ctx.PoisonFuture(activation.Pid)
+	}
+
+	for key, future := range futures {
+		if err := future.Wait(); err != nil {
+			ctx.Logger().Error("Failed to poison actor", slog.String("identity", key), slog.Any("error", err))
+		}
+
+		activation := p.actors[key]
+		if owner := p.rdv.GetByClusterIdentity(activation.ClusterIdentity); owner != "" {
+			ctx.Send(identityActorPID(owner), &clustering.ActivationTerminated{
+				Pid:             activation.Pid,
+				ClusterIdentity: activation.ClusterIdentity,
+			})
+		}
+	}
+}
+
+func (p *placementActor) onIdentityHandoverRequest(msg *clustering.IdentityHandoverRequest, ctx actor.Context) {
+	if ctx.Sender() == nil || msg.CurrentTopology == nil {
+		return
+	}
+
+	current := clustering.NewRendezvous()
+	current.UpdateMembers(msg.CurrentTopology.Members)
+
+	var delta *clustering.Rendezvous
+	if msg.DeltaTopology != nil {
+		delta = clustering.NewRendezvous()
+		delta.UpdateMembers(msg.DeltaTopology.Members)
+	}
+
+	activations := make([]*clustering.Activation, 0)
+	skipped := 0
+	for _, activation := range p.actors {
+		if current.GetByClusterIdentity(activation.ClusterIdentity) != msg.Address {
+			continue
+		}
+		// the requester already owned the identity in the previous topology and knows about it
+		if delta != nil && delta.GetByClusterIdentity(activation.ClusterIdentity) == msg.Address {
+			skipped++
+			continue
+		}
+		activations = append(activations, activation)
+	}
+
+	handover := &outgoingHandover{
+		target: ctx.Sender(),
+		chunks: handoverChunks(activations, p.config.HandoverChunkSize, msg.CurrentTopology.TopologyHash, skipped),
+	}
+	p.handovers[handover.target.String()] = handover
+	p.sendNextChunk(handover, ctx)
+}
+
+func (p *placementActor) onIdentityHandoverAck(msg *clustering.IdentityHandoverAck, ctx actor.Context) {
+	if ctx.Sender() == nil {
+		return
+	}
+
+	key := ctx.Sender().String()
+	handover, ok := p.handovers[key]
+	if !ok || handover.chunks[0].TopologyHash != msg.TopologyHash || int(msg.ChunkId) != handover.next-1 {
+		return
+	}
+
+	if msg.ProcessingState == clustering.IdentityHandoverAck_incorrect_topology || handover.next == len(handover.chunks) {
+		delete(p.handovers, key)
+		return
+	}
+
+	p.sendNextChunk(handover, ctx)
+}
+
+// dropStaleHandovers drops the handovers of earlier topologies, their requesters do not ack them anymore
+// or their last ack was lost
+func (p *placementActor) dropStaleHandovers(topologyHash uint64) {
+	for key, handover := range p.handovers {
+		if handover.chunks[0].TopologyHash != topologyHash {
+			delete(p.handovers, key)
+		}
+	}
+}
+
+func (p *placementActor) sendNextChunk(handover *outgoingHandover, ctx actor.Context) {
+	chunk := handover.chunks[handover.next]
+	handover.next++
+	ctx.Request(handover.target, packHandover(p.address, chunk))
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"time"
+)
+
+type Config struct {
+	// LockTimeout is the time after which a spawn lock without an activation is considered abandoned. Default: 10s
+	LockTimeout time.Duration
+	// WaitTimeout is the maximum time WaitForActivation waits for the lock holder. Default: 5s
+	WaitTimeout time.Duration
+	// PollInterval is the interval at which WaitForActivation checks the storage. Default: 50ms
+	PollInterval time.Duration
+	// TableName is the name of the table used by the SQL storage. Default: activations
+	TableName string
+	// Dialect is the SQL dialect used by the SQL storage. Default: SQLite
+	Dialect Dialect
+}
+
+type Option func(config *Config)
+
+// WithLockTimeout sets the time after which an abandoned spawn lock can be taken over. Default: 10s
+func WithLockTimeout(lockTimeout time.Duration) Option {
+	return func(config *Config) {
+		config.LockTimeout = lockTimeout
+	}
+}
+
+// WithWaitTimeout sets the maximum time to wait for another member to spawn an identity. Default: 5s
+func WithWaitTimeout(waitTimeout time.Duration) Option {
+	return func(config *Config) {
+		config.WaitTimeout = waitTimeout
+	}
+}
+
+// WithPollInterval sets the interval at which the storage is checked while waiting for an activation. Default: 50ms
+func WithPollInterval(pollInterval time.Duration) Option {
+	return func(config *Config) {
+		config.PollInterval = pollInterval
+	}
+}
+
+// WithTableName sets the table used by the SQL storage. Default: activations
+func WithTableName(tableName string) Option {
+	return func(config *Config) {
+		config.TableName = tableName
+	}
+}
+
+// WithDialect sets the SQL dialect used by the SQL storage. Default: SQLite
+func WithDialect(dialect Dialect) Option {
+	return func(config *Config) {
+		config.Dialect = dialect
+	}
+}
+
+func newConfig(opts ...Option) *Config {
+	config := &Config{
+		LockTimeout:  10 * time.Second,
+		WaitTimeout:  5 * time.Second,
+		PollInterval: 50 * time.Millisecond,
+		TableName:    "activations",
+		Dialect:      SQLite,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
@@ -0,0 +1,8 @@
+package storage
+
+import (
+	"errors"
+)
+
+// ErrLockNotHeld is returned when an activation is stored with a spawn lock which was released or taken over
+var ErrLockNotHeld = errors.New("spawn lock is not held")
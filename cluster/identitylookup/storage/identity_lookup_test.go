@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/cluster/cluster_test_tool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityStorageLookup_ActivatesOnce(t *testing.T) {
+	storage := NewMemoryStorage()
+	fixture := cluster_test_tool.NewBaseInMemoryClusterFixture(3,
+		cluster_test_tool.WithGetIdentityLookup(func(clusterName string) cluster.IdentityLookup {
+			return cluster.NewIdentityStorageLookup(storage)
+		}),
+		cluster_test_tool.WithGetClusterKinds(func() []*cluster.Kind {
+			return []*cluster.Kind{cluster.NewKind("echo", actor.PropsFromFunc(func(ctx actor.Context) {
+				if msg, ok := ctx.Message().(*actor.PID); ok {
+					ctx.Respond(msg)
+				}
+			}))}
+		}),
+	)
+	fixture.Initialize()
+	defer fixture.ShutDown()
+
+	members := fixture.GetMembers()
+	ci := cluster.NewClusterIdentity("singleton", "echo")
+
+	var pid *actor.PID
+	for _, member := range members {
+		res, err := member.Request(ci.Identity, ci.Kind, &actor.PID{Id: "ping"})
+		require.NoError(t, err)
+		assert.Equal(t, "ping", res.(*actor.PID).Id)
+
+		got := member.Get(ci.Identity, ci.Kind)
+		require.NotNil(t, got)
+		if pid == nil {
+			pid = got
+		}
+		assert.True(t, pid.Equal(got), "all members resolve the same activation")
+	}
+
+	activation, err := storage.TryGetExistingActivation(ci)
+	require.NoError(t, err)
+	require.NotNil(t, activation)
+	assert.True(t, pid.Equal(activation.Pid))
+
+	// when the hosting member leaves, its activations are removed from the storage
+	for _, member := range members {
+		if member.ActorSystem.ID == activation.MemberID {
+			fixture.RemoveNode(member, true)
+			break
+		}
+	}
+
+	assert.Eventually(t, func() bool {
+		activation, _ := storage.TryGetExistingActivation(ci)
+		return activation == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/google/uuid"
+)
+
+type memoryEntry struct {
+	lockID     string
+	lockedAt   time.Time
+	activation *cluster.StoredActivation
+}
+
+// MemoryStorage is a StorageLookup which keeps the activations in memory.
+// It can only be shared by cluster members running in the same process, which makes it useful for tests.
+type MemoryStorage struct {
+	config  *Config
+	mu      sync.Mutex
+	entries map[string]*memoryEntry
+}
+
+var _ cluster.StorageLookup = (*MemoryStorage)(nil)
+
+// NewMemoryStorage creates an empty in-memory storage
+func NewMemoryStorage(opts ...Option) *MemoryStorage {
+	return &MemoryStorage{
+		config:  newConfig(opts...),
+		entries: map[string]*memoryEntry{},
+	}
+}
+
+func (s *MemoryStorage) TryGetExistingActivation(clusterIdentity *cluster.ClusterIdentity) (*cluster.StoredActivation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.entries[clusterIdentity.AsKey()]; ok {
+		return entry.activation, nil
+	}
+
+	return nil, nil
+}
+
+func (s *MemoryStorage) TryAcquireLock(clusterIdentity *cluster.ClusterIdentity) (*cluster.SpawnLock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := clusterIdentity.AsKey()
+	if entry, ok := s.entries[key]; ok {
+		if entry.activation != nil || time.Since(entry.lockedAt) < s.config.LockTimeout {
+			return nil, nil
+		}
+	}
+
+	lockID := uuid.NewString()
+	s.entries[key] = &memoryEntry{lockID: lockID, lockedAt: time.Now()}
+
+	return cluster.NewSpawnLock(lockID, clusterIdentity), nil
+}
+
+func (s *MemoryStorage) WaitForActivation(clusterIdentity *cluster.ClusterIdentity) (*cluster.StoredActivation, error) {
+	key := clusterIdentity.AsKey()
+	deadline := time.Now().Add(s.config.WaitTimeout)
+
+	for {
+		s.mu.Lock()
+		entry, ok := s.entries[key]
+		s.mu.Unlock()
+
+		if !ok {
+			return nil, nil
+		}
+		if entry.activation != nil {
+			return entry.activation, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, nil
+		}
+
+		time.Sleep(s.config.PollInterval)
+	}
+}
+
+func (s *MemoryStorage) RemoveLock(spawnLock *cluster.SpawnLock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := spawnLock.ClusterIdentity.AsKey()
+	if entry, ok := s.entries[key]; ok && entry.lockID == spawnLock.LockID && entry.activation == nil {
+		delete(s.entries, key)
+	}
+
+	return nil
+}
+
+func (s *MemoryStorage) StoreActivation(memberID string, spawnLock *cluster.SpawnLock, pid *actor.PID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[spawnLock.ClusterIdentity.AsKey()]
+	if !ok || entry.lockID != spawnLock.LockID || entry.activation != nil {
+		return fmt.Errorf("%w: %s", ErrLockNotHeld, spawnLock.ClusterIdentity.ToShortString())
+	}
+
+	entry.activation = cluster.NewStoredActivation(pid, memberID)
+
+	return nil
+}
+
+func (s *MemoryStorage) RemoveActivation(clusterIdentity *cluster.ClusterIdentity, pid *actor.PID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := clusterIdentity.AsKey()
+	if entry, ok := s.entries[key]; ok && entry.activation != nil && entry.activation.Pid.Equal(pid) {
+		delete(s.entries, key)
+	}
+
+	return nil
+}
+
+func (s *MemoryStorage) RemoveMemberId(memberID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, entry := range s.entries {
+		if entry.activation != nil && entry.activation.MemberID == memberID {
+			delete(s.entries, key)
+		}
+	}
+
+	return nil
+}
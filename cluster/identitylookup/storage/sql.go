@@ -0,0 +1,181 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/google/uuid"
+)
+
+// Dialect selects the SQL flavour of the database
+type Dialect int
+
+const (
+	SQLite Dialect = iota
+	MySQL
+	Postgres
+)
+
+// rebind rewrites the ? placeholders of the query to the placeholders of the dialect
+func (d Dialect) rebind(query string) string {
+	if d != Postgres {
+		return query
+	}
+
+	var sb strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// SQLStorage is a StorageLookup which keeps the activations in a SQL database shared by all cluster members.
+// Each identity is a row keyed by kind and identity, a row without a PID is a spawn lock.
+type SQLStorage struct {
+	config *Config
+	db     *sql.DB
+}
+
+var _ cluster.StorageLookup = (*SQLStorage)(nil)
+
+// NewSQLStorage creates a storage on top of the given database, creating its table if it does not exist.
+// The caller must import the database driver.
+func NewSQLStorage(db *sql.DB, opts ...Option) (*SQLStorage, error) {
+	s := &SQLStorage{
+		config: newConfig(opts...),
+		db:     db,
+	}
+
+	if _, err := db.Exec(s.query(`CREATE TABLE IF NOT EXISTS %s (
+		kind VARCHAR(255) NOT NULL,
+		identity VARCHAR(255) NOT NULL,
+		lock_id VARCHAR(64) NOT NULL,
+		locked_at BIGINT NOT NULL,
+		member_id VARCHAR(255) NOT NULL,
+		pid_address VARCHAR(255) NOT NULL,
+		pid_id VARCHAR(255) NOT NULL,
+		PRIMARY KEY (kind, identity))`)); err != nil {
+		return nil, fmt.Errorf("failed to create table %s: %w", s.config.TableName, err)
+	}
+
+	return s, nil
+}
+
+func (s *SQLStorage) query(query string) string {
+	return s.config.Dialect.rebind(fmt.Sprintf(query, s.config.TableName))
+}
+
+func (s *SQLStorage) TryGetExistingActivation(clusterIdentity *cluster.ClusterIdentity) (*cluster.StoredActivation, error) {
+	var memberID, address, id string
+	err := s.db.QueryRow(s.query(`SELECT member_id, pid_address, pid_id FROM %s WHERE kind = ? AND identity = ? AND pid_id <> ''`),
+		clusterIdentity.Kind, clusterIdentity.Identity).Scan(&memberID, &address, &id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.NewStoredActivation(actor.NewPID(address, id), memberID), nil
+}
+
+func (s *SQLStorage) TryAcquireLock(clusterIdentity *cluster.ClusterIdentity) (*cluster.SpawnLock, error) {
+	now := time.Now()
+
+	// take over a lock whose holder never stored an activation
+	if _, err := s.db.Exec(s.query(`DELETE FROM %s WHERE kind = ? AND identity = ? AND pid_id = '' AND locked_at < ?`),
+		clusterIdentity.Kind, clusterIdentity.Identity, now.Add(-s.config.LockTimeout).UnixNano()); err != nil {
+		return nil, err
+	}
+
+	lockID := uuid.NewString()
+	_, err := s.db.Exec(s.query(`INSERT INTO %s (kind, identity, lock_id, locked_at, member_id, pid_address, pid_id) VALUES (?, ?, ?, ?, '', '', '')`),
+		clusterIdentity.Kind, clusterIdentity.Identity, lockID, now.UnixNano())
+	if err != nil {
+		// the insert fails on the primary key when the identity is locked or activated,
+		// anything else is a real error
+		var exists int
+		if qerr := s.db.QueryRow(s.query(`SELECT 1 FROM %s WHERE kind = ? AND identity = ?`),
+			clusterIdentity.Kind, clusterIdentity.Identity).Scan(&exists); qerr == nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return cluster.NewSpawnLock(lockID, clusterIdentity), nil
+}
+
+func (s *SQLStorage) WaitForActivation(clusterIdentity *cluster.ClusterIdentity) (*cluster.StoredActivation, error) {
+	deadline := time.Now().Add(s.config.WaitTimeout)
+
+	for {
+		var memberID, address, id string
+		err := s.db.QueryRow(s.query(`SELECT member_id, pid_address, pid_id FROM %s WHERE kind = ? AND identity = ?`),
+			clusterIdentity.Kind, clusterIdentity.Identity).Scan(&memberID, &address, &id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if id != "" {
+			return cluster.NewStoredActivation(actor.NewPID(address, id), memberID), nil
+		}
+		if time.Now().After(deadline) {
+			return nil, nil
+		}
+
+		time.Sleep(s.config.PollInterval)
+	}
+}
+
+func (s *SQLStorage) RemoveLock(spawnLock *cluster.SpawnLock) error {
+	_, err := s.db.Exec(s.query(`DELETE FROM %s WHERE kind = ? AND identity = ? AND lock_id = ? AND pid_id = ''`),
+		spawnLock.ClusterIdentity.Kind, spawnLock.ClusterIdentity.Identity, spawnLock.LockID)
+
+	return err
+}
+
+func (s *SQLStorage) StoreActivation(memberID string, spawnLock *cluster.SpawnLock, pid *actor.PID) error {
+	res, err := s.db.Exec(s.query(`UPDATE %s SET member_id = ?, pid_address = ?, pid_id = ? WHERE kind = ? AND identity = ? AND lock_id = ? AND pid_id = ''`),
+		memberID, pid.Address, pid.Id, spawnLock.ClusterIdentity.Kind, spawnLock.ClusterIdentity.Identity, spawnLock.LockID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", ErrLockNotHeld, spawnLock.ClusterIdentity.ToShortString())
+	}
+
+	return nil
+}
+
+func (s *SQLStorage) RemoveActivation(clusterIdentity *cluster.ClusterIdentity, pid *actor.PID) error {
+	_, err := s.db.Exec(s.query(`DELETE FROM %s WHERE kind = ? AND identity = ? AND pid_address = ? AND pid_id = ?`),
+		clusterIdentity.Kind, clusterIdentity.Identity, pid.Address, pid.Id)
+
+	return err
+}
+
+func (s *SQLStorage) RemoveMemberId(memberID string) error {
+	_, err := s.db.Exec(s.query(`DELETE FROM %s WHERE member_id = ?`), memberID)
+
+	return err
+}
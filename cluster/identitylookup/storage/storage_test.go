@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"database/sql"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func storages(t *testing.T, opts ...Option) map[string]cluster.StorageLookup {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "activations.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	// sqlite allows a single writer, serialize access instead of failing with busy errors
+	db.SetMaxOpenConns(1)
+
+	sqlStorage, err := NewSQLStorage(db, opts...)
+	require.NoError(t, err)
+
+	return map[string]cluster.StorageLookup{
+		"memory": NewMemoryStorage(opts...),
+		"sql":    sqlStorage,
+	}
+}
+
+func TestStorage_LockAndActivation(t *testing.T) {
+	for name, s := range storages(t) {
+		t.Run(name, func(t *testing.T) {
+			ci := cluster.NewClusterIdentity("a", "kind")
+			pid := actor.NewPID("127.0.0.1:1", "partition/a")
+
+			activation, err := s.TryGetExistingActivation(ci)
+			require.NoError(t, err)
+			assert.Nil(t, activation)
+
+			lock, err := s.TryAcquireLock(ci)
+			require.NoError(t, err)
+			require.NotNil(t, lock)
+
+			other, err := s.TryAcquireLock(ci)
+			require.NoError(t, err)
+			assert.Nil(t, other, "lock is already held")
+
+			require.NoError(t, s.StoreActivation("member1", lock, pid))
+
+			activation, err = s.TryGetExistingActivation(ci)
+			require.NoError(t, err)
+			require.NotNil(t, activation)
+			assert.True(t, pid.Equal(activation.Pid))
+			assert.Equal(t, "member1", activation.MemberID)
+
+			activation, err = s.WaitForActivation(ci)
+			require.NoError(t, err)
+			assert.True(t, pid.Equal(activation.Pid))
+
+			// an activation is not released by removing its lock
+			require.NoError(t, s.RemoveLock(lock))
+			activation, _ = s.TryGetExistingActivation(ci)
+			assert.NotNil(t, activation)
+
+			require.NoError(t, s.RemoveActivation(ci, actor.NewPID("127.0.0.1:1", "other")))
+			activation, _ = s.TryGetExistingActivation(ci)
+			assert.NotNil(t, activation, "a different PID does not remove the activation")
+
+			require.NoError(t, s.RemoveActivation(ci, pid))
+			activation, _ = s.TryGetExistingActivation(ci)
+			assert.Nil(t, activation)
+		})
+	}
+}
+
+func TestStorage_RemoveLock(t *testing.T) {
+	for name, s := range storages(t) {
+		t.Run(name, func(t *testing.T) {
+			ci := cluster.NewClusterIdentity("a", "kind")
+
+			lock, err := s.TryAcquireLock(ci)
+			require.NoError(t, err)
+			require.NoError(t, s.RemoveLock(lock))
+
+			err = s.StoreActivation("member1", lock, actor.NewPID("127.0.0.1:1", "a"))
+			assert.ErrorIs(t, err, ErrLockNotHeld)
+
+			activation, err := s.WaitForActivation(ci)
+			require.NoError(t, err)
+			assert.Nil(t, activation)
+
+			lock, err = s.TryAcquireLock(ci)
+			require.NoError(t, err)
+			assert.NotNil(t, lock)
+		})
+	}
+}
+
+func TestStorage_AbandonedLockIsTakenOver(t *testing.T) {
+	for name, s := range storages(t, WithLockTimeout(50*time.Millisecond)) {
+		t.Run(name, func(t *testing.T) {
+			ci := cluster.NewClusterIdentity("a", "kind")
+
+			abandoned, err := s.TryAcquireLock(ci)
+			require.NoError(t, err)
+			require.NotNil(t, abandoned)
+
+			time.Sleep(100 * time.Millisecond)
+
+			lock, err := s.TryAcquireLock(ci)
+			require.NoError(t, err)
+			require.NotNil(t, lock)
+
+			assert.ErrorIs(t, s.StoreActivation("member1", abandoned, actor.NewPID("127.0.0.1:1", "a")), ErrLockNotHeld)
+			assert.NoError(t, s.StoreActivation("member2", lock, actor.NewPID("127.0.0.1:2", "a")))
+		})
+	}
+}
+
+func TestStorage_WaitForActivation(t *testing.T) {
+	for name, s := range storages(t) {
+		t.Run(name, func(t *testing.T) {
+			ci := cluster.NewClusterIdentity("a", "kind")
+			pid := actor.NewPID("127.0.0.1:1", "a")
+
+			lock, err := s.TryAcquireLock(ci)
+			require.NoError(t, err)
+
+			go func() {
+				time.Sleep(100 * time.Millisecond)
+				_ = s.StoreActivation("member1", lock, pid)
+			}()
+
+			activation, err := s.WaitForActivation(ci)
+			require.NoError(t, err)
+			require.NotNil(t, activation)
+			assert.True(t, pid.Equal(activation.Pid))
+		})
+	}
+}
+
+func TestStorage_RemoveMemberId(t *testing.T) {
+	for name, s := range storages(t) {
+		t.Run(name, func(t *testing.T) {
+			for i, member := range []string{"member1", "member1", "member2"} {
+				ci := cluster.NewClusterIdentity(string(rune('a'+i)), "kind")
+				lock, err := s.TryAcquireLock(ci)
+				require.NoError(t, err)
+				require.NoError(t, s.StoreActivation(member, lock, actor.NewPID("127.0.0.1:1", ci.Identity)))
+			}
+
+			require.NoError(t, s.RemoveMemberId("member1"))
+
+			for i, exists := range []bool{false, false, true} {
+				activation, err := s.TryGetExistingActivation(cluster.NewClusterIdentity(string(rune('a'+i)), "kind"))
+				require.NoError(t, err)
+				assert.Equal(t, exists, activation != nil)
+			}
+		})
+	}
+}
+
+func TestStorage_ConcurrentLocksHaveOneWinner(t *testing.T) {
+	for name, s := range storages(t) {
+		t.Run(name, func(t *testing.T) {
+			ci := cluster.NewClusterIdentity("a", "kind")
+
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			winners := 0
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					lock, err := s.TryAcquireLock(ci)
+					assert.NoError(t, err)
+					if lock != nil {
+						mu.Lock()
+						winners++
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, 1, winners)
+		})
+	}
+}
+
+func TestDialect_Rebind(t *testing.T) {
+	query := "SELECT 1 FROM t WHERE a = ? AND b = ?"
+	assert.Equal(t, query, SQLite.rebind(query))
+	assert.Equal(t, "SELECT 1 FROM t WHERE a = $1 AND b = $2", Postgres.rebind(query))
+}
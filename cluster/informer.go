@@ -18,6 +18,10 @@ const (
 	TopologyKey       string = "topology"
 	HearthbeatKey     string = "heathbeat"
 	GracefullyLeftKey string = "left"
+	StartedKey        string = "started"
+	MetadataKey       string = "metadata"
+	LifecycleKey      string = "lifecycle"
+	SingletonKey      string = "singleton"
 )
 
 // create and seed a pseudo random numbers generator
@@ -77,6 +81,7 @@ func (inf *Informer) UpdateClusterTopology(topology *ClusterTopology) {
 	for _, member := range topology.Members {
 		active[member.Id] = empty{}
 	}
+	inf.activeMemberIDs = active
 
 	inf.SetState(TopologyKey, topology)
 }
@@ -1,10 +1,12 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/asynkron/gofun/set"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -209,3 +211,41 @@ func TestInformer_GetMemberStateDelta(t *testing.T) {
 		t.Error("member state delta is nil")
 	}
 }
+
+func TestInformer_TopologyConsensus(t *testing.T) {
+	t.Parallel()
+
+	a := func() set.Set[string] {
+		return set.New[string]()
+	}
+
+	i := newInformer("member1", a, 3, 3, slog.Default())
+	handler, check := NewConsensusCheckBuilder(slog.Default(), TopologyKey, func(any *anypb.Any) interface{} {
+		var topology ClusterTopology
+		_ = any.UnmarshalTo(&topology)
+
+		return topology.TopologyHash
+	}).Build()
+	i.AddConsensusCheck(handler.GetID(), check)
+
+	i.UpdateClusterTopology(&ClusterTopology{TopologyHash: 1, Members: []*Member{{Id: "member1"}}})
+	waitForConsensus(t, handler, uint64(1))
+
+	// the members agree on the next topology without disagreeing in between
+	i.UpdateClusterTopology(&ClusterTopology{TopologyHash: 2, Members: []*Member{{Id: "member1"}}})
+	waitForConsensus(t, handler, uint64(2))
+}
+
+func waitForConsensus(t *testing.T, handler ConsensusHandler, expected interface{}) {
+	deadline := time.Now().Add(time.Second)
+	for {
+		value, ok := handler.TryGetConsensus(context.Background())
+		if ok && value == expected {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected consensus on %v, got %v, %v", expected, value, ok)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// FileKeyValueStore is a key value store keeping each value in a file of a directory. The values survive the
+// restart of the member, and survive the topics moving between members when the directory is shared by them.
+type FileKeyValueStore[T proto.Message] struct {
+	dir string
+}
+
+var _ KeyValueStore[*Subscribers] = (*FileKeyValueStore[*Subscribers])(nil)
+
+// NewFileKeyValueStore creates a key value store keeping the values in the directory, creating it if needed
+func NewFileKeyValueStore[T proto.Message](dir string) (*FileKeyValueStore[T], error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create key value store directory: %w", err)
+	}
+
+	return &FileKeyValueStore[T]{dir: dir}, nil
+}
+
+func (s *FileKeyValueStore[T]) path(key string) string {
+	return filepath.Join(s.dir, url.PathEscape(key))
+}
+
+func (s *FileKeyValueStore[T]) Set(_ context.Context, key string, value T) error {
+	data, err := proto.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("could not marshal value of key %s: %w", key, err)
+	}
+
+	// the value is replaced at once, a reader never sees a partly written file
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not write value of key %s: %w", key, err)
+	}
+
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+func (s *FileKeyValueStore[T]) Get(_ context.Context, key string) (T, error) {
+	var value T
+
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return value, nil
+	}
+	if err != nil {
+		return value, fmt.Errorf("could not read value of key %s: %w", key, err)
+	}
+
+	value = value.ProtoReflect().Type().New().Interface().(T)
+	if err := proto.Unmarshal(data, value); err != nil {
+		return value, fmt.Errorf("could not unmarshal value of key %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+func (s *FileKeyValueStore[T]) Clear(_ context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("could not clear key %s: %w", key, err)
+	}
+
+	return nil
+}
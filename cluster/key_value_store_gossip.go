@@ -0,0 +1,176 @@
+package cluster
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// GossipKeyValueStore is a key value store kept in the gossip state of the cluster, so that every member
+// can read the values, without any external storage. The values are lost when the whole cluster stops.
+//
+// The value of a key is the one written last by any member, according to the clock of the member that wrote it,
+// writes in the same millisecond are ordered by member id. The clocks of the members are not synchronized:
+// a write loses to an earlier write of another member whose clock is ahead by more than the time between them.
+//
+// Clearing a key leaves a tombstone, the writing member purges it once it is older than the tombstone retention
+// and no other member holds a value of the key. Writes older than a write of another member are purged as well.
+type GossipKeyValueStore[T proto.Message] struct {
+	cluster            *Cluster
+	prefix             string
+	tombstoneRetention time.Duration
+	mutex              sync.Mutex
+	entries            map[string]*GossipStoreEntry // entries written by this member
+}
+
+var _ KeyValueStore[*Subscribers] = (*GossipKeyValueStore[*Subscribers])(nil)
+
+// NewGossipKeyValueStore creates a key value store keeping the values in the gossip state, under the key prefix.
+// The tombstones of cleared keys are kept for the retention, which has to outlast the gossip of the cluster.
+func NewGossipKeyValueStore[T proto.Message](cluster *Cluster, prefix string, tombstoneRetention time.Duration) *GossipKeyValueStore[T] {
+	return &GossipKeyValueStore[T]{
+		cluster:            cluster,
+		prefix:             prefix,
+		tombstoneRetention: tombstoneRetention,
+		entries:            map[string]*GossipStoreEntry{},
+	}
+}
+
+func (s *GossipKeyValueStore[T]) Set(_ context.Context, key string, value T) error {
+	a, err := anypb.New(value)
+	if err != nil {
+		return fmt.Errorf("could not pack value of key %s: %w", key, err)
+	}
+
+	return s.write(key, &GossipStoreEntry{Value: a, WrittenUnixMilliseconds: time.Now().UnixMilli()})
+}
+
+func (s *GossipKeyValueStore[T]) Get(_ context.Context, key string) (T, error) {
+	var value T
+
+	state, err := s.memberEntries()
+	if err != nil {
+		return value, err
+	}
+
+	s.mutex.Lock()
+	// reading is as good a time as writing to purge the entries of this member
+	if s.purge(state) {
+		if err := s.gossip(); err != nil {
+			s.cluster.Logger().Warn("could not purge gossip key value store", slog.String("prefix", s.prefix), slog.Any("error", err))
+		}
+	}
+	// the gossip state of this member may lag behind its last write
+	state[s.cluster.ActorSystem.ID] = s.entries
+	latest, _ := latestStoreEntry(state, key, "")
+	s.mutex.Unlock()
+
+	if latest == nil || latest.Value == nil {
+		return value, nil
+	}
+
+	value = value.ProtoReflect().Type().New().Interface().(T)
+	if err := latest.Value.UnmarshalTo(value); err != nil {
+		return value, fmt.Errorf("could not unpack value of key %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+func (s *GossipKeyValueStore[T]) Clear(_ context.Context, key string) error {
+	// an entry without value marks the key as cleared
+	return s.write(key, &GossipStoreEntry{WrittenUnixMilliseconds: time.Now().UnixMilli()})
+}
+
+// write sets the entry of this member for the key and gossips the entries of this member
+func (s *GossipKeyValueStore[T]) write(key string, entry *GossipStoreEntry) error {
+	state, err := s.memberEntries()
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.entries[key] = entry
+	s.purge(state)
+
+	return s.gossip()
+}
+
+func (s *GossipKeyValueStore[T]) gossip() error {
+	return s.cluster.Gossip.SetStateRequest(s.prefix, &GossipStoreEntries{Entries: s.entries})
+}
+
+// memberEntries returns the entries of the store gossiped by each member, by member id
+func (s *GossipKeyValueStore[T]) memberEntries() (map[string]map[string]*GossipStoreEntry, error) {
+	state, err := s.cluster.Gossip.GetState(s.prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]map[string]*GossipStoreEntry, len(state))
+	for memberID, kv := range state {
+		memberEntries := &GossipStoreEntries{}
+		if err := kv.Value.UnmarshalTo(memberEntries); err != nil {
+			s.cluster.Logger().Warn("could not unpack into GossipStoreEntries proto.Message form Any", slog.Any("error", err))
+			continue
+		}
+		entries[memberID] = memberEntries.Entries
+	}
+
+	return entries, nil
+}
+
+// purge drops the entries of this member other members wrote after, and its tombstones older than the retention
+// once no other member holds a value of the key, it returns whether entries were dropped.
+// The caller holds the mutex.
+func (s *GossipKeyValueStore[T]) purge(state map[string]map[string]*GossipStoreEntry) bool {
+	self := s.cluster.ActorSystem.ID
+	purged := false
+	for key, entry := range s.entries {
+		latest, latestMemberID := latestStoreEntry(state, key, self)
+		switch {
+		case latest != nil && storeEntryAfter(latest, latestMemberID, entry, self):
+		case entry.Value == nil && time.Since(time.UnixMilli(entry.WrittenUnixMilliseconds)) > s.tombstoneRetention &&
+			(latest == nil || latest.Value == nil):
+		default:
+			continue
+		}
+		delete(s.entries, key)
+		purged = true
+	}
+
+	return purged
+}
+
+// latestStoreEntry returns the entry of the key written last and the member which wrote it, leaving out a member
+func latestStoreEntry(state map[string]map[string]*GossipStoreEntry, key string, except string) (*GossipStoreEntry, string) {
+	var latest *GossipStoreEntry
+	var latestMemberID string
+	for memberID, entries := range state {
+		entry, ok := entries[key]
+		if !ok || memberID == except {
+			continue
+		}
+		if latest == nil || storeEntryAfter(entry, memberID, latest, latestMemberID) {
+			latest, latestMemberID = entry, memberID
+		}
+	}
+
+	return latest, latestMemberID
+}
+
+// storeEntryAfter reports whether the entry a was written after the entry b, the member id breaks ties
+func storeEntryAfter(a *GossipStoreEntry, aMemberID string, b *GossipStoreEntry, bMemberID string) bool {
+	if a.WrittenUnixMilliseconds != b.WrittenUnixMilliseconds {
+		return a.WrittenUnixMilliseconds > b.WrittenUnixMilliseconds
+	}
+
+	return aMemberID > bMemberID
+}
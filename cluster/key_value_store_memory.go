@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// InMemoryKeyValueStore is a key value store kept in the memory of the member, it does not survive the member.
+// The values expire after the ttl since they were set, a zero ttl keeps them until they are cleared.
+type InMemoryKeyValueStore[T any] struct {
+	ttl       time.Duration
+	mutex     sync.RWMutex
+	entries   map[string]inMemoryEntry[T]
+	lastSweep time.Time
+}
+
+var _ KeyValueStore[*Subscribers] = (*InMemoryKeyValueStore[*Subscribers])(nil)
+
+type inMemoryEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// NewInMemoryKeyValueStore creates an in memory key value store whose values expire after the ttl
+func NewInMemoryKeyValueStore[T any](ttl time.Duration) *InMemoryKeyValueStore[T] {
+	return &InMemoryKeyValueStore[T]{
+		ttl:       ttl,
+		entries:   map[string]inMemoryEntry[T]{},
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *InMemoryKeyValueStore[T]) Set(_ context.Context, key string, value T) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry := inMemoryEntry[T]{value: value}
+	if s.ttl > 0 {
+		now := time.Now()
+		entry.expires = now.Add(s.ttl)
+		s.removeExpired(now)
+	}
+	s.entries[key] = entry
+
+	return nil
+}
+
+// removeExpired removes the expired entries once per ttl, so that the keys no longer set do not pile up
+func (s *InMemoryKeyValueStore[T]) removeExpired(now time.Time) {
+	if now.Sub(s.lastSweep) <= s.ttl {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.entries {
+		if now.After(entry.expires) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+func (s *InMemoryKeyValueStore[T]) Get(_ context.Context, key string) (T, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entry, ok := s.entries[key]
+	if !ok || (s.ttl > 0 && time.Now().After(entry.expires)) {
+		var empty T
+		return empty, nil
+	}
+
+	return entry.value, nil
+}
+
+func (s *InMemoryKeyValueStore[T]) Clear(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.entries, key)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func testSubscribers(ids ...string) *Subscribers {
+	subscribers := &Subscribers{}
+	for _, id := range ids {
+		subscribers.Subscribers = append(subscribers.Subscribers, &SubscriberIdentity{
+			Identity: &SubscriberIdentity_Pid{Pid: actor.NewPID("localhost:0", id)},
+		})
+	}
+	return subscribers
+}
+
+func TestInMemoryKeyValueStore_ExpiresValues(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryKeyValueStore[*Subscribers](50 * time.Millisecond)
+
+	require.NoError(t, store.Set(ctx, "topic", testSubscribers("a")))
+	value, err := store.Get(ctx, "topic")
+	require.NoError(t, err)
+	assert.Len(t, value.Subscribers, 1)
+
+	time.Sleep(100 * time.Millisecond)
+	value, err = store.Get(ctx, "topic")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, store.Set(ctx, "topic", testSubscribers("a")))
+	require.NoError(t, store.Clear(ctx, "topic"))
+	value, err = store.Get(ctx, "topic")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestFileKeyValueStore_PersistsValues(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewFileKeyValueStore[*Subscribers](dir)
+	require.NoError(t, err)
+
+	value, err := store.Get(ctx, "orders/eu")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	expected := testSubscribers("a", "b")
+	require.NoError(t, store.Set(ctx, "orders/eu", expected))
+
+	// another store on the same directory, as after a restart, reads the value
+	reopened, err := NewFileKeyValueStore[*Subscribers](dir)
+	require.NoError(t, err)
+	value, err = reopened.Get(ctx, "orders/eu")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(expected, value))
+
+	require.NoError(t, reopened.Clear(ctx, "orders/eu"))
+	require.NoError(t, reopened.Clear(ctx, "orders/eu"))
+	value, err = store.Get(ctx, "orders/eu")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestGossipKeyValueStore_LatestEntryBreaksTiesByMemberID(t *testing.T) {
+	state := map[string]map[string]*GossipStoreEntry{
+		"a": {"topic": {WrittenUnixMilliseconds: 10}},
+		"b": {"topic": {WrittenUnixMilliseconds: 10}},
+		"c": {"topic": {WrittenUnixMilliseconds: 9}},
+	}
+
+	latest, memberID := latestStoreEntry(state, "topic", "")
+	assert.Equal(t, "b", memberID)
+	assert.Same(t, state["b"]["topic"], latest)
+
+	_, memberID = latestStoreEntry(state, "topic", "b")
+	assert.Equal(t, "a", memberID)
+}
+
+func TestGossipKeyValueStore_PurgesSupersededEntriesAndTombstones(t *testing.T) {
+	c := newClusterForTest("test-GossipKeyValueStore", nil)
+	store := NewGossipKeyValueStore[*Subscribers](c, "test/", time.Minute)
+	self := c.ActorSystem.ID
+
+	now := time.Now()
+	old := now.Add(-2 * time.Minute).UnixMilli()
+	store.entries = map[string]*GossipStoreEntry{
+		"superseded":   {WrittenUnixMilliseconds: now.UnixMilli()},
+		"recent":       {WrittenUnixMilliseconds: now.UnixMilli()},
+		"expired":      {WrittenUnixMilliseconds: old},
+		"still-needed": {WrittenUnixMilliseconds: old},
+	}
+	state := map[string]map[string]*GossipStoreEntry{
+		self:    {"superseded": {WrittenUnixMilliseconds: old}},
+		"other": {"superseded": {WrittenUnixMilliseconds: now.Add(time.Second).UnixMilli()}, "still-needed": {WrittenUnixMilliseconds: old - 1, Value: &anypb.Any{}}},
+	}
+
+	assert.True(t, store.purge(state))
+	assert.Len(t, store.entries, 2)
+	assert.Contains(t, store.entries, "recent")
+	// the value of the other member would come back if the tombstone went away
+	assert.Contains(t, store.entries, "still-needed")
+
+	assert.False(t, store.purge(state))
+}
@@ -11,6 +11,8 @@ type Kind struct {
 	Kind            string
 	Props           *actor.Props
 	StrategyBuilder func(*Cluster) MemberStrategy
+	Singleton       bool
+	Placement       *Placement
 }
 
 // NewKind creates a new instance of a kind
@@ -28,6 +30,17 @@ func (k *Kind) WithMemberStrategy(strategyBuilder func(*Cluster) MemberStrategy)
 	k.StrategyBuilder = strategyBuilder
 }
 
+// WithSingleton makes the kind a cluster singleton, a single instance runs on the member which went up first
+// among the members hosting the kind and is reached with a SingletonProxy. The kind is not activated by identity.
+func (k *Kind) WithSingleton() {
+	k.Singleton = true
+}
+
+// WithPlacement constrains the members the kind is activated on
+func (k *Kind) WithPlacement(opts ...PlacementOption) {
+	k.Placement = NewPlacement(opts...)
+}
+
 func (k *Kind) Build(cluster *Cluster) *ActivatedKind {
 	var strategy MemberStrategy = nil
 	if k.StrategyBuilder != nil {
@@ -35,17 +48,21 @@ func (k *Kind) Build(cluster *Cluster) *ActivatedKind {
 	}
 
 	return &ActivatedKind{
-		Kind:     k.Kind,
-		Props:    k.Props,
-		Strategy: strategy,
+		Kind:      k.Kind,
+		Props:     k.Props,
+		Strategy:  strategy,
+		Singleton: k.Singleton,
+		Placement: k.Placement,
 	}
 }
 
 type ActivatedKind struct {
-	Kind     string
-	Props    *actor.Props
-	Strategy MemberStrategy
-	count    int32
+	Kind      string
+	Props     *actor.Props
+	Strategy  MemberStrategy
+	Singleton bool
+	Placement *Placement
+	count     int32
 }
 
 func (ak *ActivatedKind) Inc() {
@@ -55,3 +72,8 @@ func (ak *ActivatedKind) Inc() {
 func (ak *ActivatedKind) Dev() {
 	atomic.AddInt32(&ak.count, -1)
 }
+
+// Count returns the number of activations of the kind on this member
+func (ak *ActivatedKind) Count() int64 {
+	return int64(atomic.LoadInt32(&ak.count))
+}
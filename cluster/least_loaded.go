@@ -0,0 +1,93 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+)
+
+// leastLoadedMemberStrategy activates grains on the least loaded member, according to the load gossiped
+// by the members with their heartbeats. Partitions are still placed by rendezvous hashing.
+type leastLoadedMemberStrategy struct {
+	*simpleMemberStrategy
+	cluster *Cluster
+	kind    string
+
+	mutex  sync.Mutex
+	placed map[string]*placedSince // activations placed by this member since the last load of each member
+}
+
+type placedSince struct {
+	updatedAt time.Time
+	count     int64
+}
+
+// NewLeastLoadedMemberStrategy activates the grains of the kind on the member with the fewest activations
+// of the kind and the smallest mailbox backlog, weighted by the CPU usage of the member.
+// The activations this member places between two heartbeats are counted in, so that all of them
+// do not herd onto the same member until its load is gossiped again.
+//
+//	cluster.NewKind("user", props).WithMemberStrategy(func(c *cluster.Cluster) cluster.MemberStrategy {
+//		return cluster.NewLeastLoadedMemberStrategy(c, "user")
+//	})
+func NewLeastLoadedMemberStrategy(cluster *Cluster, kind string) MemberStrategy {
+	return &leastLoadedMemberStrategy{
+		simpleMemberStrategy: newDefaultMemberStrategy(cluster, kind).(*simpleMemberStrategy),
+		cluster:              cluster,
+		kind:                 kind,
+		placed:               map[string]*placedSince{},
+	}
+}
+
+func (m *leastLoadedMemberStrategy) GetActivator(_ string) string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var activator *Member
+	var activatorPlaced *placedSince
+	var lowest float64
+	for _, member := range m.placement().Candidates(m.members) {
+		load := m.cluster.loads.get(member.Id)
+		placed := m.placedOn(member.Id, load)
+
+		score := float64(placed.count)
+		if load != nil {
+			score = float64(load.Activations[m.kind]+load.MailboxBacklog+placed.count) * (1 + load.CPUUsage)
+		}
+
+		if activator == nil || score < lowest {
+			activator, activatorPlaced, lowest = member, placed, score
+		}
+	}
+
+	if activator == nil {
+		return ""
+	}
+	activatorPlaced.count++
+
+	return activator.Address()
+}
+
+// placedOn returns the activations placed on the member, starting over once its load is updated
+func (m *leastLoadedMemberStrategy) placedOn(memberID string, load *MemberLoad) *placedSince {
+	var updatedAt time.Time
+	if load != nil {
+		updatedAt = load.UpdatedAt
+	}
+
+	placed, ok := m.placed[memberID]
+	if !ok || placed.updatedAt.Before(updatedAt) {
+		placed = &placedSince{updatedAt: updatedAt}
+		m.placed[memberID] = placed
+	}
+
+	return placed
+}
+
+func (m *leastLoadedMemberStrategy) RemoveMember(member *Member) {
+	m.simpleMemberStrategy.RemoveMember(member)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.placed, member.Id)
+}
@@ -0,0 +1,74 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLeastLoadedStrategyForTest(members Members) (*leastLoadedMemberStrategy, *Cluster) {
+	c := newClusterForTest("test-LeastLoaded", nil)
+	ms := NewLeastLoadedMemberStrategy(c, "kind").(*leastLoadedMemberStrategy)
+	for _, member := range members {
+		ms.AddMember(member)
+	}
+
+	return ms, c
+}
+
+func TestLeastLoadedMemberStrategy_PicksLeastLoadedMember(t *testing.T) {
+	members := newMembersForTest(3)
+	ms, c := newLeastLoadedStrategyForTest(members)
+
+	now := time.Now()
+	c.loads.set(&MemberLoad{MemberID: members[0].Id, Activations: map[string]int64{"kind": 10}, UpdatedAt: now})
+	c.loads.set(&MemberLoad{MemberID: members[1].Id, Activations: map[string]int64{"kind": 2}, MailboxBacklog: 1, UpdatedAt: now})
+	c.loads.set(&MemberLoad{MemberID: members[2].Id, Activations: map[string]int64{"kind": 2}, CPUUsage: 0.9, UpdatedAt: now})
+
+	assert.Equal(t, members[1].Address(), ms.GetActivator(""))
+}
+
+func TestLeastLoadedMemberStrategy_CountsPlacementsUntilLoadIsGossiped(t *testing.T) {
+	members := newMembersForTest(2)
+	ms, c := newLeastLoadedStrategyForTest(members)
+
+	now := time.Now()
+	c.loads.set(&MemberLoad{MemberID: members[0].Id, Activations: map[string]int64{"kind": 0}, UpdatedAt: now})
+	c.loads.set(&MemberLoad{MemberID: members[1].Id, Activations: map[string]int64{"kind": 4}, UpdatedAt: now})
+
+	placed := map[string]int{}
+	for i := 0; i < 10; i++ {
+		placed[ms.GetActivator("")]++
+	}
+	// the first member takes activations until it is as loaded as the second one, then they alternate
+	assert.Equal(t, 7, placed[members[0].Address()])
+	assert.Equal(t, 3, placed[members[1].Address()])
+
+	// a newer load of the first member replaces the activations counted for it
+	c.loads.set(&MemberLoad{MemberID: members[0].Id, Activations: map[string]int64{"kind": 8}, UpdatedAt: now.Add(time.Second)})
+	assert.Equal(t, members[1].Address(), ms.GetActivator(""))
+}
+
+func TestLeastLoadedMemberStrategy_HonorsPlacement(t *testing.T) {
+	kind := NewKind("kind", actor.PropsFromFunc(func(ctx actor.Context) {}))
+	kind.WithPlacement(RequireLabel("zone", "us"))
+	kind.WithMemberStrategy(func(c *Cluster) MemberStrategy {
+		return NewLeastLoadedMemberStrategy(c, "kind")
+	})
+	c := newClusterForTest("test-LeastLoadedPlacement", nil, WithKinds(kind))
+	c.initKinds()
+
+	members := newLabeledMembersForTest()
+	ms := c.GetClusterKind("kind").Strategy
+	for _, member := range members {
+		ms.AddMember(member)
+	}
+
+	c.loads.set(&MemberLoad{MemberID: members[0].Id, UpdatedAt: time.Now()})
+	c.loads.set(&MemberLoad{MemberID: members[4].Id, Activations: map[string]int64{"kind": 3}, UpdatedAt: time.Now()})
+	c.loads.set(&MemberLoad{MemberID: members[5].Id, Activations: map[string]int64{"kind": 1}, UpdatedAt: time.Now()})
+
+	assert.Equal(t, members[5].Address(), ms.GetActivator(""))
+}
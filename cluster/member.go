@@ -24,6 +24,22 @@ func (m *Member) HasKind(kind string) bool {
 	return false
 }
 
+func (m *Member) HasLabel(key, value string) bool {
+	v, ok := m.Labels[key]
+
+	return ok && v == value
+}
+
+func (m *Member) HasRole(role string) bool {
+	for _, r := range m.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Address return a "host:port".
 // Member defined by protos.proto
 func (m *Member) Address() string {
@@ -0,0 +1,237 @@
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	murmur32 "github.com/twmb/murmur3"
+	"google.golang.org/protobuf/proto"
+)
+
+// MemberUp is published on the event stream when a member of the topology is up, grains are placed on it from now on
+type MemberUp struct {
+	Member *Member
+}
+
+// MemberLeaving is published on the event stream when a member of the topology starts leaving,
+// grains are no longer placed on it
+type MemberLeaving struct {
+	Member *Member
+}
+
+// MemberDown is published on the event stream when a member is removed from the topology
+type MemberDown struct {
+	Member *Member
+}
+
+// State returns the lifecycle state of the member. A member which did not gossip its state yet is joining,
+// unless this member does not gossip, as clients do, then the members of the topology are up.
+func (ml *MemberList) State(memberID string) MemberState {
+	ml.stateMutex.RLock()
+	defer ml.stateMutex.RUnlock()
+
+	return ml.state(memberID)
+}
+
+func (ml *MemberList) state(memberID string) MemberState {
+	if state, ok := ml.states[memberID]; ok {
+		return state
+	}
+	if ml.cluster.Gossip == nil || ml.cluster.Gossip.pid == nil {
+		return MemberState_Up
+	}
+
+	return MemberState_Joining
+}
+
+// Placeable returns the members grains are placed on, the members which are up
+func (ml *MemberList) Placeable(members Members) Members {
+	ml.stateMutex.RLock()
+	defer ml.stateMutex.RUnlock()
+
+	placeable := make(Members, 0, len(members))
+	for _, m := range members {
+		if ml.state(m.Id) == MemberState_Up {
+			placeable = append(placeable, m)
+		}
+	}
+
+	return placeable
+}
+
+// onMemberLifecycle moves the member to the gossiped state, the states only move forward
+func (ml *MemberList) onMemberLifecycle(memberID string, state MemberState) {
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+
+	previous, ok := ml.advanceState(memberID, state)
+	if !ok {
+		return
+	}
+	ml.gossipLifecycle()
+
+	member := ml.members.GetMemberById(memberID)
+	if member == nil {
+		// the member is placed and its state published once it joins the topology
+		return
+	}
+
+	wasPlaceable, placeable := previous == MemberState_Up, state == MemberState_Up
+	if wasPlaceable != placeable {
+		for _, kind := range member.Kinds {
+			strategy := ml.memberStrategyByKind[kind]
+			switch {
+			case placeable && strategy == nil:
+				ml.memberStrategyByKind[kind] = ml.getMemberStrategyByKind(kind)
+				ml.memberStrategyByKind[kind].AddMember(member)
+			case placeable:
+				strategy.AddMember(member)
+			case strategy != nil:
+				strategy.RemoveMember(member)
+			}
+		}
+	}
+
+	ml.publishMemberState(member, previous, state)
+	// the grains of this member are handed over when it leaves, rather than moved away by the identity lookup
+	leaving := memberID == ml.cluster.ActorSystem.ID && !placeable
+	if wasPlaceable != placeable && !leaving {
+		// the members are the same, the placement of their grains is not
+		ml.publishTopology()
+	}
+}
+
+// advanceState moves the member to the state unless it is already there or further, and returns its previous state
+func (ml *MemberList) advanceState(memberID string, state MemberState) (MemberState, bool) {
+	ml.stateMutex.Lock()
+	defer ml.stateMutex.Unlock()
+
+	previous := ml.state(memberID)
+	if _, ok := ml.states[memberID]; ok && state <= previous {
+		return previous, false
+	}
+	ml.states[memberID] = state
+
+	return previous, true
+}
+
+// publishMemberState publishes the event of the state the member moved to
+func (ml *MemberList) publishMemberState(member *Member, previous MemberState, state MemberState) {
+	ml.cluster.Logger().Info("member state changed", slog.String("member", member.Id),
+		slog.String("from", previous.String()), slog.String("to", state.String()))
+
+	switch {
+	case state == MemberState_Up:
+		ml.cluster.ActorSystem.EventStream.Publish(&MemberUp{Member: member})
+	case state >= MemberState_Leaving && previous < MemberState_Leaving && state != MemberState_Down:
+		ml.cluster.ActorSystem.EventStream.Publish(&MemberLeaving{Member: member})
+	}
+}
+
+// setLifecycle moves this member to the state, and gossips it
+func (c *Cluster) setLifecycle(state MemberState) {
+	c.MemberList.onMemberLifecycle(c.ActorSystem.ID, state)
+}
+
+// gossipLifecycle gossips the state of this member with its view of the states of the members, when either changed.
+// The caller holds mutex.
+func (ml *MemberList) gossipLifecycle() {
+	if ml.cluster.Gossip == nil || ml.cluster.Gossip.pid == nil {
+		return
+	}
+
+	lifecycle := &MemberLifecycle{State: ml.State(ml.cluster.ActorSystem.ID), ViewHash: ml.viewHash()}
+	if proto.Equal(lifecycle, ml.lifecycle) {
+		return
+	}
+	ml.lifecycle = lifecycle
+	ml.cluster.Gossip.SetState(LifecycleKey, lifecycle)
+}
+
+// viewHash hashes the states this member knows of the members of the topology. The caller holds mutex.
+func (ml *MemberList) viewHash() uint64 {
+	ml.stateMutex.RLock()
+	defer ml.stateMutex.RUnlock()
+
+	var view strings.Builder
+	for _, member := range ml.members.Members() {
+		view.WriteString(member.Id)
+		view.WriteString(ml.state(member.Id).String())
+	}
+
+	return murmur32.Sum64([]byte(view.String()))
+}
+
+// awaitLifecycleAgreed waits until the members agree on the state of this member, or the timeout of the cluster
+func (c *Cluster) awaitLifecycleAgreed() {
+	// without waiting for the next gossip round
+	c.Gossip.SendState()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.Config.TimeoutTime)
+	defer cancel()
+	ticker := time.NewTicker(c.Config.GossipInterval / 2)
+	defer ticker.Stop()
+
+	for !c.MemberList.lifecycleAgreed() {
+		select {
+		case <-ctx.Done():
+			c.Logger().Warn("Members did not agree on the state of this member", slog.String("state", c.MemberList.State(c.ActorSystem.ID).String()))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lifecycleAgreed reports whether the members gossip the view of the states this member gossips
+func (ml *MemberList) lifecycleAgreed() bool {
+	if ml.lifecycleConsensus == nil {
+		return true
+	}
+
+	ml.mutex.RLock()
+	lifecycle := ml.lifecycle
+	ml.mutex.RUnlock()
+	if lifecycle == nil {
+		return true
+	}
+
+	hash, ok := ml.lifecycleConsensus.TryGetConsensus(context.Background())
+
+	return ok && hash == lifecycle.ViewHash
+}
+
+// startLifecycle gossips this member as joining, and moves it up once the members agree on a topology with it
+func (c *Cluster) startLifecycle() {
+	c.setLifecycle(MemberState_Joining)
+
+	go func() {
+		ticker := time.NewTicker(c.Config.GossipInterval)
+		defer ticker.Stop()
+
+		for !c.ActorSystem.IsStopped() {
+			<-ticker.C
+			if c.MemberList.State(c.ActorSystem.ID) != MemberState_Joining {
+				return
+			}
+			if c.MemberList.topologyAgreed() {
+				c.setLifecycle(MemberState_Up)
+				c.advertiseSingletons()
+				return
+			}
+		}
+	}()
+}
+
+// topologyAgreed reports whether the members agree on the current topology, and it has this member
+func (ml *MemberList) topologyAgreed() bool {
+	if ml.topologyConsensus == nil {
+		return false
+	}
+
+	hash, ok := ml.TopologyConsensus(context.Background())
+	members := ml.Members()
+
+	return ok && hash == members.TopologyHash() && members.ContainsID(ml.cluster.ActorSystem.ID)
+}
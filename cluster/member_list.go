@@ -8,6 +8,7 @@ import (
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/eventstream"
 	"github.com/asynkron/protoactor-go/remote"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -19,9 +20,20 @@ type MemberList struct {
 	mutex                sync.RWMutex
 	members              *MemberSet
 	memberStrategyByKind map[string]MemberStrategy
+	metadata             map[string]*MemberMetadata // gossiped labels and roles by member id
 
-	eventSteam        *eventstream.EventStream
-	topologyConsensus ConsensusHandler
+	// the lifecycle states by member id, read by the subscribers of the topology published while holding mutex
+	stateMutex sync.RWMutex
+	states     map[string]MemberState
+
+	// the gossiped placements by member id and kind, read by the subscribers of the topology as well
+	placementMutex sync.RWMutex
+	placements     map[string]map[string]*Placement
+
+	eventSteam         *eventstream.EventStream
+	topologyConsensus  ConsensusHandler
+	lifecycleConsensus ConsensusHandler
+	lifecycle          *MemberLifecycle // gossiped last by this member
 }
 
 func NewMemberList(cluster *Cluster) *MemberList {
@@ -29,11 +41,36 @@ func NewMemberList(cluster *Cluster) *MemberList {
 		cluster:              cluster,
 		members:              emptyMemberSet,
 		memberStrategyByKind: make(map[string]MemberStrategy),
+		metadata:             make(map[string]*MemberMetadata),
+		states:               make(map[string]MemberState),
+		placements:           make(map[string]map[string]*Placement),
 		eventSteam:           cluster.ActorSystem.EventStream,
 	}
 	memberList.eventSteam.Subscribe(func(evt interface{}) {
 		switch t := evt.(type) {
 		case *GossipUpdate:
+			if t.Key == MetadataKey {
+				var metadata MemberMetadata
+				if err := t.Value.UnmarshalTo(&metadata); err != nil {
+					cluster.Logger().Warn("could not unpack into MemberMetadata proto.Message form Any", slog.Any("error", err))
+
+					break
+				}
+				memberList.onMemberMetadata(t.MemberID, &metadata)
+
+				break
+			}
+			if t.Key == LifecycleKey {
+				var lifecycle MemberLifecycle
+				if err := t.Value.UnmarshalTo(&lifecycle); err != nil {
+					cluster.Logger().Warn("could not unpack into MemberLifecycle proto.Message form Any", slog.Any("error", err))
+
+					break
+				}
+				memberList.onMemberLifecycle(t.MemberID, lifecycle.State)
+
+				break
+			}
 			if t.Key != "topology" {
 				break
 			}
@@ -71,6 +108,20 @@ func (ml *MemberList) InitializeTopologyConsensus() {
 	})
 }
 
+// InitializeLifecycleConsensus checks whether the members gossip the same view of the states of the members
+func (ml *MemberList) InitializeLifecycleConsensus() {
+	ml.lifecycleConsensus = ml.cluster.Gossip.RegisterConsensusCheck(LifecycleKey, func(any *anypb.Any) interface{} {
+		var lifecycle MemberLifecycle
+		if unpackErr := any.UnmarshalTo(&lifecycle); unpackErr != nil {
+			ml.cluster.Logger().Error("could not unpack lifecycle message", slog.Any("error", unpackErr))
+
+			return nil
+		}
+
+		return lifecycle.ViewHash
+	})
+}
+
 func (ml *MemberList) TopologyConsensus(ctx context.Context) (uint64, bool) {
 	result, ok := ml.topologyConsensus.TryGetConsensus(ctx)
 	if ok {
@@ -134,6 +185,10 @@ func (ml *MemberList) UpdateClusterTopology(members Members) {
 	// then makes a delta between new and old members
 	// notifying the cluster accordingly which members left or joined
 
+	for _, m := range members {
+		ml.fillMetadata(m)
+	}
+
 	topology, done, active, joined, left := ml.getTopologyChanges(members)
 	if done {
 		return
@@ -158,6 +213,7 @@ func (ml *MemberList) UpdateClusterTopology(members Members) {
 	}
 
 	ml.cluster.ActorSystem.EventStream.Publish(topology)
+	ml.gossipLifecycle()
 
 	ml.cluster.Logger().Info("Updated ClusterTopology",
 		slog.Uint64("topology-hash", topology.TopologyHash),
@@ -168,15 +224,95 @@ func (ml *MemberList) UpdateClusterTopology(members Members) {
 		slog.Int("membersFromProvider", len(members)))
 }
 
+// fillMetadata sets the labels and roles of a member whose cluster provider does not publish them
+func (ml *MemberList) fillMetadata(m *Member) {
+	if len(m.Labels) > 0 || len(m.Roles) > 0 {
+		return
+	}
+
+	if m.Id == ml.cluster.ActorSystem.ID {
+		m.Labels, m.Roles = ml.cluster.Config.Labels, ml.cluster.Config.Roles
+
+		return
+	}
+
+	if metadata, ok := ml.metadata[m.Id]; ok {
+		m.Labels, m.Roles = metadata.Labels, metadata.Roles
+	}
+}
+
+// onMemberMetadata keeps the gossiped labels, roles and placements of a member, a member which already joined
+// without them is replaced in the member strategies and the topology is published again
+func (ml *MemberList) onMemberMetadata(memberID string, metadata *MemberMetadata) {
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+
+	ml.metadata[memberID] = metadata
+	hasPlacements := ml.setPlacements(memberID, metadata.Placements)
+
+	member := ml.members.GetMemberById(memberID)
+	if member == nil {
+		return
+	}
+
+	updated := proto.Clone(member).(*Member)
+	ml.fillMetadata(updated)
+	if len(member.Labels) > 0 || len(member.Roles) > 0 || (len(updated.Labels) == 0 && len(updated.Roles) == 0) {
+		if hasPlacements {
+			// the members are the same, the placement of their kinds is not
+			ml.publishTopology()
+		}
+
+		return
+	}
+
+	members := make(Members, 0, ml.members.Len())
+	for _, m := range ml.members.Members() {
+		if m.Id == memberID {
+			m = updated
+		}
+		members = append(members, m)
+	}
+	ml.members = NewMemberSet(members)
+
+	for _, kind := range member.Kinds {
+		if strategy := ml.memberStrategyByKind[kind]; strategy != nil {
+			strategy.RemoveMember(member)
+			strategy.AddMember(updated)
+		}
+	}
+
+	// the members are the same, the placement of their kinds may not be
+	ml.publishTopology()
+}
+
+// publishTopology publishes the current topology again, without members joining nor leaving
+func (ml *MemberList) publishTopology() {
+	ml.cluster.ActorSystem.EventStream.Publish(&ClusterTopology{
+		TopologyHash: ml.members.TopologyHash(),
+		Members:      ml.members.Members(),
+		Joined:       make(Members, 0),
+		Left:         make(Members, 0),
+	})
+}
+
 func (ml *MemberList) memberJoin(joiningMember *Member) {
 	ml.cluster.Logger().Info("member joined", slog.String("member", joiningMember.Id))
 
+	// grains are placed on the member once it is up
+	up := ml.State(joiningMember.Id) == MemberState_Up
 	for _, kind := range joiningMember.Kinds {
 		if ml.memberStrategyByKind[kind] == nil {
 			ml.memberStrategyByKind[kind] = ml.getMemberStrategyByKind(kind)
 		}
 
-		ml.memberStrategyByKind[kind].AddMember(joiningMember)
+		if up {
+			ml.memberStrategyByKind[kind].AddMember(joiningMember)
+		}
+	}
+
+	if up {
+		ml.cluster.ActorSystem.EventStream.Publish(&MemberUp{Member: joiningMember})
 	}
 }
 
@@ -188,6 +324,12 @@ func (ml *MemberList) memberLeave(leavingMember *Member) {
 
 		ml.memberStrategyByKind[kind].RemoveMember(leavingMember)
 	}
+
+	ml.stateMutex.Lock()
+	ml.states[leavingMember.Id] = MemberState_Down
+	ml.stateMutex.Unlock()
+	ml.setPlacements(leavingMember.Id, nil)
+	ml.cluster.ActorSystem.EventStream.Publish(&MemberDown{Member: leavingMember})
 }
 
 func (ml *MemberList) getTopologyChanges(members Members) (topology *ClusterTopology, unchanged bool, active *MemberSet, joined *MemberSet, left *MemberSet) {
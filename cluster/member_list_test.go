@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"sync"
@@ -284,3 +285,104 @@ func TestMemberList_newMemberStrategies(t *testing.T) {
 		a.Equal(v, len(obj.memberStrategyByKind["kind2"].GetAllMembers()))
 	}
 }
+
+func TestMemberList_GossipedMetadata(t *testing.T) {
+	c := newClusterForTest("test-GossipedMetadata", nil)
+	obj := NewMemberList(c)
+
+	members := newMembersForTest(2)
+	obj.onMemberMetadata(members[1].Id, &MemberMetadata{Roles: []string{"gpu-less"}})
+	obj.UpdateClusterTopology(members)
+
+	assert.Empty(t, obj.Members().GetMemberById(members[0].Id).Roles)
+	assert.Equal(t, []string{"gpu-less"}, obj.Members().GetMemberById(members[1].Id).Roles)
+
+	// metadata gossiped after the member joined replaces it in the member strategies
+	obj.onMemberMetadata(members[0].Id, &MemberMetadata{Labels: map[string]string{"zone": "eu"}})
+	assert.Equal(t, map[string]string{"zone": "eu"}, obj.Members().GetMemberById(members[0].Id).Labels)
+
+	strategy := obj.memberStrategyByKind["kind"]
+	assert.Len(t, strategy.GetAllMembers(), 2)
+	for _, member := range strategy.GetAllMembers() {
+		if member.Id == members[0].Id {
+			assert.Equal(t, "eu", member.Labels["zone"])
+		}
+	}
+}
+
+func TestMemberList_Lifecycle(t *testing.T) {
+	c := newClusterForTest("test-Lifecycle", nil)
+	obj := NewMemberList(c)
+	c.MemberList = obj
+
+	var mutex sync.Mutex
+	var events []interface{}
+	c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		switch evt.(type) {
+		case *MemberUp, *MemberLeaving, *MemberDown:
+			mutex.Lock()
+			events = append(events, evt)
+			mutex.Unlock()
+		}
+	})
+
+	members := newMembersForTest(2)
+	obj.onMemberLifecycle(members[0].Id, MemberState_Up)
+	obj.UpdateClusterTopology(members)
+	assert.Len(t, obj.memberStrategyByKind["kind"].GetAllMembers(), 2)
+
+	// a leaving member no longer gets grains, and does not come back up
+	obj.onMemberLifecycle(members[0].Id, MemberState_Leaving)
+	obj.onMemberLifecycle(members[0].Id, MemberState_Up)
+	assert.Equal(t, MemberState_Leaving, obj.State(members[0].Id))
+	assert.Equal(t, Members{members[1]}, obj.Placeable(members))
+	assert.Len(t, obj.memberStrategyByKind["kind"].GetAllMembers(), 1)
+
+	obj.UpdateClusterTopology(members[1:])
+	assert.Equal(t, MemberState_Down, obj.State(members[0].Id))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []interface{}{
+		&MemberUp{Member: members[0]},
+		&MemberUp{Member: members[1]},
+		&MemberLeaving{Member: members[0]},
+		&MemberDown{Member: members[0]},
+	}, events)
+}
+
+// fixedConsensus is a consensus handler which always reports the same consensus
+type fixedConsensus struct {
+	value interface{}
+}
+
+func (f fixedConsensus) GetID() string { return "fixed" }
+
+func (f fixedConsensus) TryGetConsensus(context.Context) (interface{}, bool) {
+	return f.value, f.value != nil
+}
+
+func TestMemberList_LifecycleAgreed(t *testing.T) {
+	members := newMembersForTest(2)
+	newMemberList := func() *MemberList {
+		c := newClusterForTest("test-LifecycleAgreed", nil)
+		c.MemberList.UpdateClusterTopology(members)
+		return c.MemberList
+	}
+	first, second := newMemberList(), newMemberList()
+
+	// members knowing the same states have the same view
+	assert.Equal(t, first.viewHash(), second.viewHash())
+	first.onMemberLifecycle(members[0].Id, MemberState_Leaving)
+	assert.NotEqual(t, first.viewHash(), second.viewHash())
+	second.onMemberLifecycle(members[0].Id, MemberState_Leaving)
+	assert.Equal(t, first.viewHash(), second.viewHash())
+
+	first.lifecycle = &MemberLifecycle{State: MemberState_Leaving, ViewHash: first.viewHash()}
+	first.lifecycleConsensus = fixedConsensus{}
+	assert.False(t, first.lifecycleAgreed())
+	first.lifecycleConsensus = fixedConsensus{value: first.viewHash() + 1}
+	assert.False(t, first.lifecycleAgreed())
+	first.lifecycleConsensus = fixedConsensus{value: first.viewHash()}
+	assert.True(t, first.lifecycleAgreed())
+}
@@ -0,0 +1,168 @@
+package cluster
+
+import (
+	"log/slog"
+	rtmetrics "runtime/metrics"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// MemberLoad is the load of a member, gossiped with its heartbeat
+type MemberLoad struct {
+	MemberID       string
+	Activations    map[string]int64 // activations by kind
+	MailboxBacklog int64            // user messages waiting in the mailboxes of the grains
+	CPUUsage       float64          // share of the CPU available to the process in use, from 0 to 1
+	UpdatedAt      time.Time
+}
+
+func newMemberLoad(memberID string, heartbeat *MemberHeartbeat) *MemberLoad {
+	load := &MemberLoad{
+		MemberID:       memberID,
+		Activations:    map[string]int64{},
+		MailboxBacklog: heartbeat.MailboxBacklog,
+		CPUUsage:       heartbeat.CpuUsage,
+		UpdatedAt:      time.Now(),
+	}
+	for kind, count := range heartbeat.GetActorStatistics().GetActorCount() {
+		load.Activations[kind] = count
+	}
+
+	return load
+}
+
+// memberLoads keeps the last load of each member
+type memberLoads struct {
+	mutex sync.RWMutex
+	loads map[string]*MemberLoad
+	cpu   cpuSampler
+}
+
+func newMemberLoads() *memberLoads {
+	return &memberLoads{loads: map[string]*MemberLoad{}}
+}
+
+func (ml *memberLoads) set(load *MemberLoad) {
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+
+	ml.loads[load.MemberID] = load
+}
+
+func (ml *memberLoads) get(memberID string) *MemberLoad {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	return ml.loads[memberID]
+}
+
+func (ml *memberLoads) remove(memberID string) {
+	ml.mutex.Lock()
+	defer ml.mutex.Unlock()
+
+	delete(ml.loads, memberID)
+}
+
+func (ml *memberLoads) all() map[string]*MemberLoad {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	loads := make(map[string]*MemberLoad, len(ml.loads))
+	for id, load := range ml.loads {
+		loads[id] = load
+	}
+
+	return loads
+}
+
+// MemberLoads returns the last load gossiped by each member, including this one
+func (c *Cluster) MemberLoads() map[string]*MemberLoad {
+	return c.loads.all()
+}
+
+// subscribeToMemberLoads keeps the loads gossiped with the heartbeats of the members
+func (c *Cluster) subscribeToMemberLoads() {
+	c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		switch e := evt.(type) {
+		case *GossipUpdate:
+			if e.Key != HearthbeatKey {
+				return
+			}
+
+			var heartbeat MemberHeartbeat
+			if err := e.Value.UnmarshalTo(&heartbeat); err != nil {
+				c.Logger().Warn("could not unpack into MemberHeartbeat proto.Message form Any", slog.Any("error", err))
+				return
+			}
+			c.loads.set(newMemberLoad(e.MemberID, &heartbeat))
+		case *ClusterTopology:
+			for _, member := range e.Left {
+				c.loads.remove(member.Id)
+			}
+		}
+	})
+}
+
+// memberHeartbeat collects the load of this member for its gossiped heartbeat
+func (c *Cluster) memberHeartbeat() *MemberHeartbeat {
+	counts := map[string]int64{}
+	for name, kind := range c.kinds {
+		if count := kind.Count(); count > 0 {
+			counts[name] = count
+		}
+	}
+
+	var backlog int64
+	c.activations.Range(func(_, value interface{}) bool {
+		process, ok := c.ActorSystem.ProcessRegistry.GetLocal(value.(*Activation).Pid.Id)
+		if ap, isActor := process.(*actor.ActorProcess); ok && isActor {
+			backlog += int64(ap.UserMessageCount())
+		}
+		return true
+	})
+
+	heartbeat := &MemberHeartbeat{
+		ActorStatistics: &ActorStatistics{ActorCount: counts},
+		MailboxBacklog:  backlog,
+		CpuUsage:        c.loads.cpu.usage(),
+	}
+	c.loads.set(newMemberLoad(c.ActorSystem.ID, heartbeat))
+
+	return heartbeat
+}
+
+// cpuSampler estimates the CPU usage of the process since the previous sample from the runtime metrics
+type cpuSampler struct {
+	mutex     sync.Mutex
+	lastTotal float64
+	lastIdle  float64
+	lastUsage float64
+}
+
+func (s *cpuSampler) usage() float64 {
+	samples := []rtmetrics.Sample{
+		{Name: "/cpu/classes/total:cpu-seconds"},
+		{Name: "/cpu/classes/idle:cpu-seconds"},
+	}
+	rtmetrics.Read(samples)
+	if samples[0].Value.Kind() != rtmetrics.KindFloat64 || samples[1].Value.Kind() != rtmetrics.KindFloat64 {
+		return 0
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	total, idle := samples[0].Value.Float64(), samples[1].Value.Float64()
+	// the runtime updates the estimates from time to time, keep the last usage until it did
+	if elapsed := total - s.lastTotal; elapsed > 0 {
+		s.lastUsage = 1 - (idle-s.lastIdle)/elapsed
+		if s.lastUsage < 0 {
+			s.lastUsage = 0
+		}
+	}
+	s.lastTotal, s.lastIdle = total, idle
+
+	return s.lastUsage
+}
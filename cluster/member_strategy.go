@@ -1,5 +1,7 @@
 package cluster
 
+import "sync/atomic"
+
 type MemberStrategy interface {
 	GetAllMembers() Members
 	AddMember(member *Member)
@@ -12,15 +14,33 @@ type simpleMemberStrategy struct {
 	members Members
 	rr      *SimpleRoundRobin
 	rdv     *Rendezvous
+	kind    string
+	// placements returns the placement of a kind, it is looked up on every activation as the strategy
+	// is built before the kinds are registered and the placements of other members are gossiped later
+	placements func(kind string) *Placement
+	counter    uint32
 }
 
 func newDefaultMemberStrategy(cluster *Cluster, kind string) MemberStrategy {
-	ms := &simpleMemberStrategy{members: make(Members, 0)}
+	ms := &simpleMemberStrategy{members: make(Members, 0), kind: kind}
 	ms.rr = NewSimpleRoundRobin(MemberStrategy(ms))
 	ms.rdv = NewRendezvous()
+	if cluster != nil {
+		ms.placements = cluster.Placement
+		ms.rdv.WithPlacement(cluster.Placement)
+	}
 	return ms
 }
 
+// placement returns the placement of the kind, nil if it is not constrained
+func (m *simpleMemberStrategy) placement() *Placement {
+	if m.placements == nil {
+		return nil
+	}
+
+	return m.placements(m.kind)
+}
+
 func (m *simpleMemberStrategy) AddMember(member *Member) {
 	m.members = append(m.members, member)
 	m.rdv.UpdateMembers(m.members)
@@ -54,5 +74,9 @@ func (m *simpleMemberStrategy) GetPartition(key string) string {
 }
 
 func (m *simpleMemberStrategy) GetActivator(senderAddress string) string {
+	if placement := m.placement(); placement != nil {
+		return placement.roundRobin(m.members, int(atomic.AddUint32(&m.counter, 1)))
+	}
+
 	return m.rr.GetByRoundRobin()
 }
@@ -0,0 +1,235 @@
+package cluster
+
+import (
+	"sort"
+)
+
+// Placement constrains the members a kind is activated on. Members without the required labels and roles
+// never host the kind, members with the preferred labels and roles are picked whenever one of them is
+// available, and activations are spread evenly across the values of the spread label.
+// A nil Placement places the kind on any member hosting it.
+type Placement struct {
+	RequiredLabels  map[string]string
+	RequiredRoles   []string
+	PreferredLabels map[string]string
+	PreferredRoles  []string
+	SpreadLabel     string
+}
+
+type PlacementOption func(placement *Placement)
+
+// RequireLabel only places the kind on members with the label
+func RequireLabel(key, value string) PlacementOption {
+	return func(p *Placement) {
+		if p.RequiredLabels == nil {
+			p.RequiredLabels = map[string]string{}
+		}
+		p.RequiredLabels[key] = value
+	}
+}
+
+// RequireRole only places the kind on members with the role
+func RequireRole(role string) PlacementOption {
+	return func(p *Placement) {
+		p.RequiredRoles = append(p.RequiredRoles, role)
+	}
+}
+
+// PreferLabel places the kind on members with the label when one is available
+func PreferLabel(key, value string) PlacementOption {
+	return func(p *Placement) {
+		if p.PreferredLabels == nil {
+			p.PreferredLabels = map[string]string{}
+		}
+		p.PreferredLabels[key] = value
+	}
+}
+
+// PreferRole places the kind on members with the role when one is available
+func PreferRole(role string) PlacementOption {
+	return func(p *Placement) {
+		p.PreferredRoles = append(p.PreferredRoles, role)
+	}
+}
+
+// SpreadAcross spreads the activations of the kind evenly across the values of the label,
+// e.g. across zones regardless of the number of members in each zone
+func SpreadAcross(label string) PlacementOption {
+	return func(p *Placement) {
+		p.SpreadLabel = label
+	}
+}
+
+func NewPlacement(opts ...PlacementOption) *Placement {
+	p := &Placement{}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Allows reports whether the member has the required labels and roles
+func (p *Placement) Allows(member *Member) bool {
+	if p == nil {
+		return true
+	}
+
+	return hasLabelsAndRoles(member, p.RequiredLabels, p.RequiredRoles)
+}
+
+// Candidates returns the members allowed to host the kind, only the preferred ones if any of them is allowed
+func (p *Placement) Candidates(members Members) Members {
+	if p == nil {
+		return members
+	}
+
+	allowed := make(Members, 0, len(members))
+	preferred := make(Members, 0, len(members))
+	for _, member := range members {
+		if !p.Allows(member) {
+			continue
+		}
+		allowed = append(allowed, member)
+		if hasLabelsAndRoles(member, p.PreferredLabels, p.PreferredRoles) {
+			preferred = append(preferred, member)
+		}
+	}
+
+	if len(preferred) > 0 && (len(p.PreferredLabels) > 0 || len(p.PreferredRoles) > 0) {
+		return preferred
+	}
+
+	return allowed
+}
+
+// spread groups the members by the value of the spread label, ordered by value
+func (p *Placement) spread(members Members) []Members {
+	if p == nil || p.SpreadLabel == "" || len(members) == 0 {
+		return []Members{members}
+	}
+
+	byValue := map[string]Members{}
+	for _, member := range members {
+		value := member.Labels[p.SpreadLabel]
+		byValue[value] = append(byValue[value], member)
+	}
+
+	values := make([]string, 0, len(byValue))
+	for value := range byValue {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	groups := make([]Members, len(values))
+	for i, value := range values {
+		groups[i] = byValue[value]
+	}
+
+	return groups
+}
+
+// roundRobin returns the address of the nth candidate, alternating between the groups of the spread label
+func (p *Placement) roundRobin(members Members, n int) string {
+	groups := p.spread(p.Candidates(members))
+	if len(groups[0]) == 0 {
+		return ""
+	}
+
+	group := groups[n%len(groups)]
+
+	return group[(n/len(groups))%len(group)].Address()
+}
+
+// toProto returns the gossiped form of the placement
+func (p *Placement) toProto() *KindPlacement {
+	return &KindPlacement{
+		RequiredLabels:  p.RequiredLabels,
+		RequiredRoles:   p.RequiredRoles,
+		PreferredLabels: p.PreferredLabels,
+		PreferredRoles:  p.PreferredRoles,
+		SpreadLabel:     p.SpreadLabel,
+	}
+}
+
+func placementFromProto(kp *KindPlacement) *Placement {
+	return &Placement{
+		RequiredLabels:  kp.RequiredLabels,
+		RequiredRoles:   kp.RequiredRoles,
+		PreferredLabels: kp.PreferredLabels,
+		PreferredRoles:  kp.PreferredRoles,
+		SpreadLabel:     kp.SpreadLabel,
+	}
+}
+
+// memberMetadata returns the metadata this member gossips, nil if it has no labels, roles or kind placements
+func (c *Cluster) memberMetadata() *MemberMetadata {
+	placements := map[string]*KindPlacement{}
+	for name, kind := range c.kinds {
+		if kind.Placement != nil {
+			placements[name] = kind.Placement.toProto()
+		}
+	}
+
+	if len(c.Config.Labels) == 0 && len(c.Config.Roles) == 0 && len(placements) == 0 {
+		return nil
+	}
+
+	return &MemberMetadata{Labels: c.Config.Labels, Roles: c.Config.Roles, Placements: placements}
+}
+
+// placement returns the placement of the kind. Members which define the kind with a placement gossip it,
+// so that the members which do not define it, or define it without a placement, place it alike.
+// When members define different placements for a kind, the one of the member with the lowest id applies.
+func (ml *MemberList) placement(kind string) *Placement {
+	ml.placementMutex.RLock()
+	defer ml.placementMutex.RUnlock()
+
+	var ownerID string
+	var placement *Placement
+	if clusterKind, ok := ml.cluster.kinds[kind]; ok && clusterKind.Placement != nil {
+		ownerID, placement = ml.cluster.ActorSystem.ID, clusterKind.Placement
+	}
+	for memberID, placements := range ml.placements {
+		if p, ok := placements[kind]; ok && (placement == nil || memberID < ownerID) {
+			ownerID, placement = memberID, p
+		}
+	}
+
+	return placement
+}
+
+// setPlacements keeps the gossiped placements of a member, and reports whether the member has any
+func (ml *MemberList) setPlacements(memberID string, placements map[string]*KindPlacement) bool {
+	ml.placementMutex.Lock()
+	defer ml.placementMutex.Unlock()
+
+	if len(placements) == 0 {
+		delete(ml.placements, memberID)
+
+		return false
+	}
+
+	byKind := make(map[string]*Placement, len(placements))
+	for kind, placement := range placements {
+		byKind[kind] = placementFromProto(placement)
+	}
+	ml.placements[memberID] = byKind
+
+	return true
+}
+
+func hasLabelsAndRoles(member *Member, labels map[string]string, roles []string) bool {
+	for key, value := range labels {
+		if !member.HasLabel(key, value) {
+			return false
+		}
+	}
+	for _, role := range roles {
+		if !member.HasRole(role) {
+			return false
+		}
+	}
+
+	return true
+}
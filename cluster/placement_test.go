@@ -0,0 +1,123 @@
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLabeledMembersForTest() Members {
+	members := newMembersForTest(6)
+	zones := []string{"eu", "eu", "eu", "eu", "us", "us"}
+	for i, member := range members {
+		member.Labels = map[string]string{"zone": zones[i]}
+	}
+	members[1].Roles = []string{"gpu-less"}
+	members[5].Roles = []string{"gpu-less"}
+
+	return members
+}
+
+func TestPlacement_Candidates(t *testing.T) {
+	members := newLabeledMembersForTest()
+
+	var none *Placement
+	assert.Equal(t, members, none.Candidates(members))
+
+	eu := NewPlacement(RequireLabel("zone", "eu")).Candidates(members)
+	assert.Equal(t, members[0:4], eu)
+
+	preferred := NewPlacement(RequireLabel("zone", "eu"), PreferRole("gpu-less")).Candidates(members)
+	assert.Equal(t, Members{members[1]}, preferred)
+
+	// no allowed member is preferred, any allowed member is a candidate
+	fallback := NewPlacement(RequireLabel("zone", "us"), PreferLabel("disk", "ssd")).Candidates(members)
+	assert.Equal(t, members[4:6], fallback)
+
+	assert.Empty(t, NewPlacement(RequireRole("gpu")).Candidates(members))
+}
+
+func TestPlacement_RoundRobinSpreadsAcrossLabel(t *testing.T) {
+	members := newLabeledMembersForTest()
+	placement := NewPlacement(SpreadAcross("zone"))
+
+	perZone := map[string]int{}
+	for n := 0; n < 100; n++ {
+		address := placement.roundRobin(members, n)
+		for _, member := range members {
+			if member.Address() == address {
+				perZone[member.Labels["zone"]]++
+			}
+		}
+	}
+
+	// 4 members in eu and 2 in us, the activations are still split evenly between the zones
+	assert.Equal(t, map[string]int{"eu": 50, "us": 50}, perZone)
+}
+
+func TestRendezvous_WithPlacement(t *testing.T) {
+	members := newLabeledMembersForTest()
+	placement := NewPlacement(RequireLabel("zone", "eu"), SpreadAcross("zone"))
+
+	rdv := NewRendezvous().WithPlacement(func(kind string) *Placement { return placement })
+	rdv.UpdateMembers(members)
+
+	allowed := map[string]bool{}
+	for _, member := range members[0:4] {
+		allowed[member.Address()] = true
+	}
+
+	owners := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		owner := rdv.GetByClusterIdentity(NewClusterIdentity(fmt.Sprintf("identity-%d", i), "kind"))
+		assert.True(t, allowed[owner], owner)
+		owners[owner] = true
+	}
+	assert.Len(t, owners, 4)
+}
+
+func TestMemberStrategy_GetActivatorHonorsPlacement(t *testing.T) {
+	props := actor.PropsFromFunc(func(ctx actor.Context) {})
+	defaultKind := NewKind("default", props)
+	defaultKind.WithPlacement(RequireLabel("zone", "us"))
+	leastLoadedKind := NewKind("least-loaded", props)
+	leastLoadedKind.WithPlacement(RequireLabel("zone", "us"))
+	leastLoadedKind.WithMemberStrategy(func(c *Cluster) MemberStrategy {
+		return NewLeastLoadedMemberStrategy(c, "least-loaded")
+	})
+
+	c := newClusterForTest("test-StrategyPlacement", nil, WithKinds(defaultKind, leastLoadedKind))
+	c.initKinds()
+
+	members := newLabeledMembersForTest()
+	for _, member := range members {
+		member.Kinds = []string{"default", "least-loaded"}
+	}
+	c.MemberList.UpdateClusterTopology(members)
+
+	for _, kind := range []string{"default", "least-loaded"} {
+		for i := 0; i < 10; i++ {
+			activator := c.MemberList.GetActivatorMember(kind, "")
+			assert.Contains(t, []string{members[4].Address(), members[5].Address()}, activator, kind)
+		}
+	}
+}
+
+func TestCluster_GossipedPlacement(t *testing.T) {
+	c := newClusterForTest("test-GossipedPlacement", nil)
+	assert.Nil(t, c.Placement("kind"))
+
+	eu := NewPlacement(RequireLabel("zone", "eu"))
+	c.MemberList.onMemberMetadata("member-b", &MemberMetadata{Placements: map[string]*KindPlacement{"kind": eu.toProto()}})
+	assert.Equal(t, eu, c.Placement("kind"))
+
+	// the placement of the member with the lowest id applies
+	us := NewPlacement(RequireLabel("zone", "us"), SpreadAcross("rack"))
+	c.MemberList.onMemberMetadata("member-a", &MemberMetadata{Placements: map[string]*KindPlacement{"kind": us.toProto()}})
+	assert.Equal(t, us, c.Placement("kind"))
+
+	c.MemberList.memberLeave(&Member{Id: "member-a"})
+	assert.Equal(t, eu, c.Placement("kind"))
+}
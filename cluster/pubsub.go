@@ -12,12 +12,14 @@ const PubSubDeliveryName = "$pubsub-delivery"
 var pubsubExtensionID = extensions.NextExtensionID()
 
 type PubSub struct {
-	cluster *Cluster
+	cluster  *Cluster
+	patterns *topicPatterns
 }
 
 func NewPubSub(cluster *Cluster) *PubSub {
 	p := &PubSub{
-		cluster: cluster,
+		cluster:  cluster,
+		patterns: newTopicPatterns(),
 	}
 	cluster.ActorSystem.Extensions.Register(p)
 	return p
@@ -26,12 +28,19 @@ func NewPubSub(cluster *Cluster) *PubSub {
 // Start the PubSubMemberDeliveryActor
 func (p *PubSub) Start() {
 	props := actor.PropsFromProducer(func() actor.Actor {
-		return NewPubSubMemberDeliveryActor(p.cluster.Config.PubSubConfig.SubscriberTimeout, p.cluster.Logger())
+		config := p.cluster.Config.PubSubConfig
+		deliveryActor := NewPubSubMemberDeliveryActor(config.SubscriberTimeout, p.cluster.Logger())
+		if config.DeliveryAttempts > 0 {
+			deliveryActor.deliveryAttempts = config.DeliveryAttempts
+		}
+		deliveryActor.deadLetterTopics = config.DeadLetterTopics
+		return deliveryActor
 	})
 	_, err := p.cluster.ActorSystem.Root.SpawnNamed(props, PubSubDeliveryName)
 	if err != nil {
 		panic(err) // let it crash
 	}
+	p.subscribeToTopicPatterns()
 	p.cluster.Logger().Info("Started Cluster PubSub")
 }
 
@@ -45,11 +54,37 @@ type PubSubConfig struct {
 	// This value gets rounded to seconds for optimization of cancellation token creation. Note that internally,
 	// cluster request is used to deliver messages to ClusterIdentity subscribers.
 	SubscriberTimeout time.Duration
+
+	// SubscriberStore produces the store the topics persist their subscribers in, so that the subscribers survive
+	// a topic moving to another member. Default is nil, the subscribers are not persisted.
+	SubscriberStore SubscriberStoreProducer
+
+	// TopicLog makes the topics durable when set: the published batches are appended to the log, and replayed to
+	// the subscribers from the offset they acknowledged last. Default is nil, topics only forward the batches.
+	TopicLog TopicLog
+
+	// DeliveryAttempts is the number of times a batch is delivered to a subscriber that times out or fails to
+	// process it, before the subscriber is reported as failing. Default is 1, failed deliveries are not retried.
+	DeliveryAttempts int
+
+	// DeadLetterTopics publishes the messages the subscribers of a topic fail to process, after the delivery attempts,
+	// to the dead-letter topic of the topic, see DeadLetterTopic. Default is false, the messages are dropped.
+	DeadLetterTopics bool
 }
 
+// SubscriberStoreProducer creates the store of the subscribers of the topics for the cluster
+type SubscriberStoreProducer func(cluster *Cluster) KeyValueStore[*Subscribers]
+
+// subscriberStorePrefix prefixes the gossip keys of the subscribers when they are persisted in the gossip state
+const subscriberStorePrefix = "pubsub-subscribers/"
+
+// subscriberStoreTombstoneRetention is how long the gossip state keeps the subscribers of a topic once they are cleared
+const subscriberStoreTombstoneRetention = time.Minute
+
 func newPubSubConfig() *PubSubConfig {
 	return &PubSubConfig{
 		SubscriberTimeout: 5 * time.Second,
+		DeliveryAttempts:  1,
 	}
 }
 
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.31.0
 // 	protoc        v4.24.3
 // source: pubsub.proto
 
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -138,6 +139,10 @@ type SubscriberIdentity struct {
 	//	*SubscriberIdentity_Pid
 	//	*SubscriberIdentity_ClusterIdentity
 	Identity isSubscriberIdentity_Identity `protobuf_oneof:"Identity"`
+	// Consumer group of the subscriber, each batch goes to one subscriber of the group. Empty when the subscriber gets every batch
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// Filter of the messages delivered to the subscriber, all the messages when not set
+	Filter *SubscriptionFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *SubscriberIdentity) Reset() {
@@ -193,6 +198,20 @@ func (x *SubscriberIdentity) GetClusterIdentity() *ClusterIdentity {
 	return nil
 }
 
+func (x *SubscriberIdentity) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SubscriberIdentity) GetFilter() *SubscriptionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type isSubscriberIdentity_Identity interface {
 	isSubscriberIdentity_Identity()
 }
@@ -209,6 +228,64 @@ func (*SubscriberIdentity_Pid) isSubscriberIdentity_Identity() {}
 
 func (*SubscriberIdentity_ClusterIdentity) isSubscriberIdentity_Identity() {}
 
+// Server-side filter of the messages delivered to a subscriber, a message passes when it meets all the conditions
+type SubscriptionFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Full names of the message types that pass, all types when empty
+	TypeNames []string `protobuf:"bytes,1,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"`
+	// Header values the messages must have
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SubscriptionFilter) Reset() {
+	*x = SubscriptionFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionFilter) ProtoMessage() {}
+
+func (x *SubscriptionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionFilter.ProtoReflect.Descriptor instead.
+func (*SubscriptionFilter) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscriptionFilter) GetTypeNames() []string {
+	if x != nil {
+		return x.TypeNames
+	}
+	return nil
+}
+
+func (x *SubscriptionFilter) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 // First request to initialize the actor.
 type Initialize struct {
 	state         protoimpl.MessageState
@@ -221,7 +298,7 @@ type Initialize struct {
 func (x *Initialize) Reset() {
 	*x = Initialize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[1]
+		mi := &file_pubsub_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -234,7 +311,7 @@ func (x *Initialize) String() string {
 func (*Initialize) ProtoMessage() {}
 
 func (x *Initialize) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[1]
+	mi := &file_pubsub_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +324,7 @@ func (x *Initialize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Initialize.ProtoReflect.Descriptor instead.
 func (*Initialize) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{1}
+	return file_pubsub_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Initialize) GetIdleTimeout() *durationpb.Duration {
@@ -266,7 +343,7 @@ type Acknowledge struct {
 func (x *Acknowledge) Reset() {
 	*x = Acknowledge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[2]
+		mi := &file_pubsub_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -279,7 +356,7 @@ func (x *Acknowledge) String() string {
 func (*Acknowledge) ProtoMessage() {}
 
 func (x *Acknowledge) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[2]
+	mi := &file_pubsub_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +369,7 @@ func (x *Acknowledge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Acknowledge.ProtoReflect.Descriptor instead.
 func (*Acknowledge) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{2}
+	return file_pubsub_proto_rawDescGZIP(), []int{3}
 }
 
 // A list of subscribers
@@ -307,7 +384,7 @@ type Subscribers struct {
 func (x *Subscribers) Reset() {
 	*x = Subscribers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[3]
+		mi := &file_pubsub_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -320,7 +397,7 @@ func (x *Subscribers) String() string {
 func (*Subscribers) ProtoMessage() {}
 
 func (x *Subscribers) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[3]
+	mi := &file_pubsub_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +410,7 @@ func (x *Subscribers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subscribers.ProtoReflect.Descriptor instead.
 func (*Subscribers) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{3}
+	return file_pubsub_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Subscribers) GetSubscribers() []*SubscriberIdentity {
@@ -350,12 +427,18 @@ type SubscribeRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Subscriber *SubscriberIdentity `protobuf:"bytes,1,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	// Where a durable topic starts delivering to the subscriber
+	Start *SubscriptionStart `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// Consumer group the subscriber joins, each batch goes to one subscriber of the group
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// Filter of the messages delivered to the subscriber
+	Filter *SubscriptionFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[4]
+		mi := &file_pubsub_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -368,7 +451,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[4]
+	mi := &file_pubsub_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +464,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{4}
+	return file_pubsub_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SubscribeRequest) GetSubscriber() *SubscriberIdentity {
@@ -391,6 +474,111 @@ func (x *SubscribeRequest) GetSubscriber() *SubscriberIdentity {
 	return nil
 }
 
+func (x *SubscribeRequest) GetStart() *SubscriptionStart {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetFilter() *SubscriptionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Position a durable topic replays its retained batches from when a subscriber subscribes.
+// When not set, the topic resumes after the offset the subscriber acknowledged last, or delivers the next batches only
+type SubscriptionStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Position:
+	//	*SubscriptionStart_Beginning
+	//	*SubscriptionStart_Time
+	Position isSubscriptionStart_Position `protobuf_oneof:"position"`
+}
+
+func (x *SubscriptionStart) Reset() {
+	*x = SubscriptionStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionStart) ProtoMessage() {}
+
+func (x *SubscriptionStart) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionStart.ProtoReflect.Descriptor instead.
+func (*SubscriptionStart) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *SubscriptionStart) GetPosition() isSubscriptionStart_Position {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+func (x *SubscriptionStart) GetBeginning() bool {
+	if x, ok := x.GetPosition().(*SubscriptionStart_Beginning); ok {
+		return x.Beginning
+	}
+	return false
+}
+
+func (x *SubscriptionStart) GetTime() *timestamppb.Timestamp {
+	if x, ok := x.GetPosition().(*SubscriptionStart_Time); ok {
+		return x.Time
+	}
+	return nil
+}
+
+type isSubscriptionStart_Position interface {
+	isSubscriptionStart_Position()
+}
+
+type SubscriptionStart_Beginning struct {
+	// Replay all the retained batches
+	Beginning bool `protobuf:"varint,1,opt,name=beginning,proto3,oneof"`
+}
+
+type SubscriptionStart_Time struct {
+	// Replay the retained batches published at or after the time
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3,oneof"`
+}
+
+func (*SubscriptionStart_Beginning) isSubscriptionStart_Position() {}
+
+func (*SubscriptionStart_Time) isSubscriptionStart_Position() {}
+
 // Subscribe acknowledgement
 type SubscribeResponse struct {
 	state         protoimpl.MessageState
@@ -401,7 +589,7 @@ type SubscribeResponse struct {
 func (x *SubscribeResponse) Reset() {
 	*x = SubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[5]
+		mi := &file_pubsub_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +602,7 @@ func (x *SubscribeResponse) String() string {
 func (*SubscribeResponse) ProtoMessage() {}
 
 func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[5]
+	mi := &file_pubsub_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +615,7 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{5}
+	return file_pubsub_proto_rawDescGZIP(), []int{7}
 }
 
 // Sent to topic actor to remove a subscriber
@@ -442,7 +630,7 @@ type UnsubscribeRequest struct {
 func (x *UnsubscribeRequest) Reset() {
 	*x = UnsubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[6]
+		mi := &file_pubsub_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -455,7 +643,7 @@ func (x *UnsubscribeRequest) String() string {
 func (*UnsubscribeRequest) ProtoMessage() {}
 
 func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[6]
+	mi := &file_pubsub_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +656,7 @@ func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
 func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{6}
+	return file_pubsub_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UnsubscribeRequest) GetSubscriber() *SubscriberIdentity {
@@ -488,20 +676,360 @@ type UnsubscribeResponse struct {
 func (x *UnsubscribeResponse) Reset() {
 	*x = UnsubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[7]
+		mi := &file_pubsub_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{9}
+}
+
+// Message sent from publisher to topic actor
+// See also PubSubBatch
+type PubSubBatchTransport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeNames []string          `protobuf:"bytes,1,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"`
+	Envelopes []*PubSubEnvelope `protobuf:"bytes,2,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+}
+
+func (x *PubSubBatchTransport) Reset() {
+	*x = PubSubBatchTransport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubBatchTransport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubBatchTransport) ProtoMessage() {}
+
+func (x *PubSubBatchTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubBatchTransport.ProtoReflect.Descriptor instead.
+func (*PubSubBatchTransport) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PubSubBatchTransport) GetTypeNames() []string {
+	if x != nil {
+		return x.TypeNames
+	}
+	return nil
+}
+
+func (x *PubSubBatchTransport) GetEnvelopes() []*PubSubEnvelope {
+	if x != nil {
+		return x.Envelopes
+	}
+	return nil
+}
+
+// Contains message byte representation and type reference
+type PubSubEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeId       int32             `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	MessageData  []byte            `protobuf:"bytes,2,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
+	SerializerId int32             `protobuf:"varint,3,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
+	Headers      map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PubSubEnvelope) Reset() {
+	*x = PubSubEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubEnvelope) ProtoMessage() {}
+
+func (x *PubSubEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubEnvelope.ProtoReflect.Descriptor instead.
+func (*PubSubEnvelope) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PubSubEnvelope) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *PubSubEnvelope) GetMessageData() []byte {
+	if x != nil {
+		return x.MessageData
+	}
+	return nil
+}
+
+func (x *PubSubEnvelope) GetSerializerId() int32 {
+	if x != nil {
+		return x.SerializerId
+	}
+	return 0
+}
+
+func (x *PubSubEnvelope) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Message a subscriber failed to process, published to the dead-letter topic of its topic
+type PubSubDeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Topic the message was published to
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Subscriber that failed to process the message, the last one tried for a consumer group
+	Subscriber *SubscriberIdentity `protobuf:"bytes,2,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Status     DeliveryStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=cluster.DeliveryStatus" json:"status,omitempty"`
+	// Error of the last delivery attempt
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Number of delivery attempts
+	Attempts     int32             `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	TypeName     string            `protobuf:"bytes,6,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	MessageData  []byte            `protobuf:"bytes,7,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
+	SerializerId int32             `protobuf:"varint,8,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
+	Headers      map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PubSubDeadLetter) Reset() {
+	*x = PubSubDeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubDeadLetter) ProtoMessage() {}
+
+func (x *PubSubDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubDeadLetter.ProtoReflect.Descriptor instead.
+func (*PubSubDeadLetter) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PubSubDeadLetter) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetSubscriber() *SubscriberIdentity {
+	if x != nil {
+		return x.Subscriber
+	}
+	return nil
+}
+
+func (x *PubSubDeadLetter) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_Delivered
+}
+
+func (x *PubSubDeadLetter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PubSubDeadLetter) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetMessageData() []byte {
+	if x != nil {
+		return x.MessageData
+	}
+	return nil
+}
+
+func (x *PubSubDeadLetter) GetSerializerId() int32 {
+	if x != nil {
+		return x.SerializerId
+	}
+	return 0
+}
+
+func (x *PubSubDeadLetter) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Patterns of the wildcard topics with subscribers on a member, gossiped so that the topics forward their batches to them
+type PubSubPatterns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+}
+
+func (x *PubSubPatterns) Reset() {
+	*x = PubSubPatterns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubPatterns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubPatterns) ProtoMessage() {}
+
+func (x *PubSubPatterns) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubPatterns.ProtoReflect.Descriptor instead.
+func (*PubSubPatterns) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PubSubPatterns) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+// Message sent from topic to delivery actor
+type DeliverBatchRequestTransport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscribers *Subscribers          `protobuf:"bytes,1,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Batch       *PubSubBatchTransport `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Topic       string                `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Offset of the batch in the log of a durable topic, 0 when the topic is not durable
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Consumer groups the batch is delivered to
+	Groups []*GroupDelivery `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *DeliverBatchRequestTransport) Reset() {
+	*x = DeliverBatchRequestTransport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pubsub_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubscribeResponse) String() string {
+func (x *DeliverBatchRequestTransport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeResponse) ProtoMessage() {}
+func (*DeliverBatchRequestTransport) ProtoMessage() {}
 
-func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[7]
+func (x *DeliverBatchRequestTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,39 +1040,73 @@ func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
-func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeliverBatchRequestTransport.ProtoReflect.Descriptor instead.
+func (*DeliverBatchRequestTransport) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{14}
 }
 
-// Message sent from publisher to topic actor
-// See also PubSubBatch
-type PubSubBatchTransport struct {
+func (x *DeliverBatchRequestTransport) GetSubscribers() *Subscribers {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+func (x *DeliverBatchRequestTransport) GetBatch() *PubSubBatchTransport {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *DeliverBatchRequestTransport) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DeliverBatchRequestTransport) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DeliverBatchRequestTransport) GetGroups() []*GroupDelivery {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Delivery of a batch to one subscriber of a consumer group, the candidates are tried in order until one processes it
+type GroupDelivery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TypeNames []string          `protobuf:"bytes,1,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"`
-	Envelopes []*PubSubEnvelope `protobuf:"bytes,2,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+	Group      string                `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Candidates []*SubscriberIdentity `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
 }
 
-func (x *PubSubBatchTransport) Reset() {
-	*x = PubSubBatchTransport{}
+func (x *GroupDelivery) Reset() {
+	*x = GroupDelivery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[8]
+		mi := &file_pubsub_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PubSubBatchTransport) String() string {
+func (x *GroupDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubSubBatchTransport) ProtoMessage() {}
+func (*GroupDelivery) ProtoMessage() {}
 
-func (x *PubSubBatchTransport) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[8]
+func (x *GroupDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,53 +1117,53 @@ func (x *PubSubBatchTransport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubSubBatchTransport.ProtoReflect.Descriptor instead.
-func (*PubSubBatchTransport) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GroupDelivery.ProtoReflect.Descriptor instead.
+func (*GroupDelivery) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PubSubBatchTransport) GetTypeNames() []string {
+func (x *GroupDelivery) GetGroup() string {
 	if x != nil {
-		return x.TypeNames
+		return x.Group
 	}
-	return nil
+	return ""
 }
 
-func (x *PubSubBatchTransport) GetEnvelopes() []*PubSubEnvelope {
+func (x *GroupDelivery) GetCandidates() []*SubscriberIdentity {
 	if x != nil {
-		return x.Envelopes
+		return x.Candidates
 	}
 	return nil
 }
 
-// Contains message byte representation and type reference
-type PubSubEnvelope struct {
+// Message sent from delivery actor to a durable topic with the subscribers that processed the batch at the offset
+type AcknowledgeDeliveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TypeId       int32  `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
-	MessageData  []byte `protobuf:"bytes,2,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
-	SerializerId int32  `protobuf:"varint,3,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
+	Subscribers []*SubscriberIdentity `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Offset      int64                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Groups      []string              `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *PubSubEnvelope) Reset() {
-	*x = PubSubEnvelope{}
+func (x *AcknowledgeDeliveryRequest) Reset() {
+	*x = AcknowledgeDeliveryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[9]
+		mi := &file_pubsub_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PubSubEnvelope) String() string {
+func (x *AcknowledgeDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubSubEnvelope) ProtoMessage() {}
+func (*AcknowledgeDeliveryRequest) ProtoMessage() {}
 
-func (x *PubSubEnvelope) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[9]
+func (x *AcknowledgeDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,60 +1174,56 @@ func (x *PubSubEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubSubEnvelope.ProtoReflect.Descriptor instead.
-func (*PubSubEnvelope) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AcknowledgeDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PubSubEnvelope) GetTypeId() int32 {
+func (x *AcknowledgeDeliveryRequest) GetSubscribers() []*SubscriberIdentity {
 	if x != nil {
-		return x.TypeId
+		return x.Subscribers
 	}
-	return 0
+	return nil
 }
 
-func (x *PubSubEnvelope) GetMessageData() []byte {
+func (x *AcknowledgeDeliveryRequest) GetOffset() int64 {
 	if x != nil {
-		return x.MessageData
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-func (x *PubSubEnvelope) GetSerializerId() int32 {
+func (x *AcknowledgeDeliveryRequest) GetGroups() []string {
 	if x != nil {
-		return x.SerializerId
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-// Message sent from topic to delivery actor
-type DeliverBatchRequestTransport struct {
+// Ack to the delivery actor after acknowledging the delivered offset
+type AcknowledgeDeliveryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Subscribers *Subscribers          `protobuf:"bytes,1,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
-	Batch       *PubSubBatchTransport `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
-	Topic       string                `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
 }
 
-func (x *DeliverBatchRequestTransport) Reset() {
-	*x = DeliverBatchRequestTransport{}
+func (x *AcknowledgeDeliveryResponse) Reset() {
+	*x = AcknowledgeDeliveryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[10]
+		mi := &file_pubsub_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeliverBatchRequestTransport) String() string {
+func (x *AcknowledgeDeliveryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliverBatchRequestTransport) ProtoMessage() {}
+func (*AcknowledgeDeliveryResponse) ProtoMessage() {}
 
-func (x *DeliverBatchRequestTransport) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[10]
+func (x *AcknowledgeDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pubsub_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,30 +1234,9 @@ func (x *DeliverBatchRequestTransport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliverBatchRequestTransport.ProtoReflect.Descriptor instead.
-func (*DeliverBatchRequestTransport) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *DeliverBatchRequestTransport) GetSubscribers() *Subscribers {
-	if x != nil {
-		return x.Subscribers
-	}
-	return nil
-}
-
-func (x *DeliverBatchRequestTransport) GetBatch() *PubSubBatchTransport {
-	if x != nil {
-		return x.Batch
-	}
-	return nil
-}
-
-func (x *DeliverBatchRequestTransport) GetTopic() string {
-	if x != nil {
-		return x.Topic
-	}
-	return ""
+// Deprecated: Use AcknowledgeDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_pubsub_proto_rawDescGZIP(), []int{17}
 }
 
 // Message sent from delivery actor to topic to notify of subscribers that fail to process the messages
@@ -709,12 +1246,14 @@ type NotifyAboutFailingSubscribersRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	InvalidDeliveries []*SubscriberDeliveryReport `protobuf:"bytes,1,rep,name=invalid_deliveries,json=invalidDeliveries,proto3" json:"invalid_deliveries,omitempty"`
+	// Consumer groups whose candidates all failed to process the batch
+	FailedGroups []string `protobuf:"bytes,2,rep,name=failed_groups,json=failedGroups,proto3" json:"failed_groups,omitempty"`
 }
 
 func (x *NotifyAboutFailingSubscribersRequest) Reset() {
 	*x = NotifyAboutFailingSubscribersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[11]
+		mi := &file_pubsub_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -727,7 +1266,7 @@ func (x *NotifyAboutFailingSubscribersRequest) String() string {
 func (*NotifyAboutFailingSubscribersRequest) ProtoMessage() {}
 
 func (x *NotifyAboutFailingSubscribersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[11]
+	mi := &file_pubsub_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +1279,7 @@ func (x *NotifyAboutFailingSubscribersRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use NotifyAboutFailingSubscribersRequest.ProtoReflect.Descriptor instead.
 func (*NotifyAboutFailingSubscribersRequest) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{11}
+	return file_pubsub_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *NotifyAboutFailingSubscribersRequest) GetInvalidDeliveries() []*SubscriberDeliveryReport {
@@ -750,6 +1289,13 @@ func (x *NotifyAboutFailingSubscribersRequest) GetInvalidDeliveries() []*Subscri
 	return nil
 }
 
+func (x *NotifyAboutFailingSubscribersRequest) GetFailedGroups() []string {
+	if x != nil {
+		return x.FailedGroups
+	}
+	return nil
+}
+
 // Ack to the delivery actor after notification of subscribers that fail to process the messages
 type NotifyAboutFailingSubscribersResponse struct {
 	state         protoimpl.MessageState
@@ -760,7 +1306,7 @@ type NotifyAboutFailingSubscribersResponse struct {
 func (x *NotifyAboutFailingSubscribersResponse) Reset() {
 	*x = NotifyAboutFailingSubscribersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[12]
+		mi := &file_pubsub_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -773,7 +1319,7 @@ func (x *NotifyAboutFailingSubscribersResponse) String() string {
 func (*NotifyAboutFailingSubscribersResponse) ProtoMessage() {}
 
 func (x *NotifyAboutFailingSubscribersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[12]
+	mi := &file_pubsub_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1332,7 @@ func (x *NotifyAboutFailingSubscribersResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use NotifyAboutFailingSubscribersResponse.ProtoReflect.Descriptor instead.
 func (*NotifyAboutFailingSubscribersResponse) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{12}
+	return file_pubsub_proto_rawDescGZIP(), []int{19}
 }
 
 // Contains information about a failed delivery
@@ -802,7 +1348,7 @@ type SubscriberDeliveryReport struct {
 func (x *SubscriberDeliveryReport) Reset() {
 	*x = SubscriberDeliveryReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[13]
+		mi := &file_pubsub_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -815,7 +1361,7 @@ func (x *SubscriberDeliveryReport) String() string {
 func (*SubscriberDeliveryReport) ProtoMessage() {}
 
 func (x *SubscriberDeliveryReport) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[13]
+	mi := &file_pubsub_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,7 +1374,7 @@ func (x *SubscriberDeliveryReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriberDeliveryReport.ProtoReflect.Descriptor instead.
 func (*SubscriberDeliveryReport) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{13}
+	return file_pubsub_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SubscriberDeliveryReport) GetSubscriber() *SubscriberIdentity {
@@ -859,7 +1405,7 @@ type PubSubAutoRespondBatchTransport struct {
 func (x *PubSubAutoRespondBatchTransport) Reset() {
 	*x = PubSubAutoRespondBatchTransport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[14]
+		mi := &file_pubsub_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -872,7 +1418,7 @@ func (x *PubSubAutoRespondBatchTransport) String() string {
 func (*PubSubAutoRespondBatchTransport) ProtoMessage() {}
 
 func (x *PubSubAutoRespondBatchTransport) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[14]
+	mi := &file_pubsub_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,7 +1431,7 @@ func (x *PubSubAutoRespondBatchTransport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSubAutoRespondBatchTransport.ProtoReflect.Descriptor instead.
 func (*PubSubAutoRespondBatchTransport) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{14}
+	return file_pubsub_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *PubSubAutoRespondBatchTransport) GetTypeNames() []string {
@@ -915,7 +1461,7 @@ type PublishResponse struct {
 func (x *PublishResponse) Reset() {
 	*x = PublishResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pubsub_proto_msgTypes[15]
+		mi := &file_pubsub_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -928,7 +1474,7 @@ func (x *PublishResponse) String() string {
 func (*PublishResponse) ProtoMessage() {}
 
 func (x *PublishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pubsub_proto_msgTypes[15]
+	mi := &file_pubsub_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1487,7 @@ func (x *PublishResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return file_pubsub_proto_rawDescGZIP(), []int{15}
+	return file_pubsub_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PublishResponse) GetStatus() PublishStatus {
@@ -958,106 +1504,201 @@ var file_pubsub_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x70, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x50, 0x49, 0x44, 0x48, 0x00, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48, 0x00,
-	0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x42, 0x0a, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x49, 0x0a,
-	0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x69,
-	0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64, 0x6c,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x70,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x50, 0x49, 0x44, 0x48, 0x00, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x49, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69,
+	0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x0b, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x12, 0x55,
-	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x71, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x09, 0x62,
+	0x65, 0x67, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51,
+	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x53,
+	0x75, 0x62, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x35, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62,
+	0x53, 0x75, 0x62, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x03, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
 	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22, 0x15,
-	0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09,
-	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
-	0x70, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x72, 0x73, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12,
-	0x33, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x78, 0x0a, 0x24, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x50, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x25, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x62,
-	0x6f, 0x75, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01,
-	0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x77, 0x0a, 0x1f, 0x50, 0x75, 0x62, 0x53,
-	0x75, 0x62, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
-	0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x65, 0x6e,
-	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x45, 0x6e,
-	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
-	0x73, 0x22, 0x41, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x2a, 0x5d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x65, 0x64, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x4e, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x68,
-	0x61, 0x62, 0x6c, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x7f, 0x2a, 0x23, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x6b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x79, 0x6e, 0x6b, 0x72, 0x6f, 0x6e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2c, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a,
+	0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x2e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x22, 0x62, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1a, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x9d, 0x01, 0x0a, 0x24, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x62, 0x6f, 0x75,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x12, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x22, 0x27, 0x0a, 0x25, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x62, 0x6f, 0x75, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x77, 0x0a, 0x1f, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x41,
+	0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2a, 0x5d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x4e, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x7f,
+	0x2a, 0x23, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x6b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x79, 0x6e, 0x6b, 0x72, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x67, 0x6f, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1073,50 +1714,73 @@ func file_pubsub_proto_rawDescGZIP() []byte {
 }
 
 var file_pubsub_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_pubsub_proto_goTypes = []interface{}{
 	(DeliveryStatus)(0),                           // 0: cluster.DeliveryStatus
 	(PublishStatus)(0),                            // 1: cluster.PublishStatus
 	(*SubscriberIdentity)(nil),                    // 2: cluster.SubscriberIdentity
-	(*Initialize)(nil),                            // 3: cluster.Initialize
-	(*Acknowledge)(nil),                           // 4: cluster.Acknowledge
-	(*Subscribers)(nil),                           // 5: cluster.Subscribers
-	(*SubscribeRequest)(nil),                      // 6: cluster.SubscribeRequest
-	(*SubscribeResponse)(nil),                     // 7: cluster.SubscribeResponse
-	(*UnsubscribeRequest)(nil),                    // 8: cluster.UnsubscribeRequest
-	(*UnsubscribeResponse)(nil),                   // 9: cluster.UnsubscribeResponse
-	(*PubSubBatchTransport)(nil),                  // 10: cluster.PubSubBatchTransport
-	(*PubSubEnvelope)(nil),                        // 11: cluster.PubSubEnvelope
-	(*DeliverBatchRequestTransport)(nil),          // 12: cluster.DeliverBatchRequestTransport
-	(*NotifyAboutFailingSubscribersRequest)(nil),  // 13: cluster.NotifyAboutFailingSubscribersRequest
-	(*NotifyAboutFailingSubscribersResponse)(nil), // 14: cluster.NotifyAboutFailingSubscribersResponse
-	(*SubscriberDeliveryReport)(nil),              // 15: cluster.SubscriberDeliveryReport
-	(*PubSubAutoRespondBatchTransport)(nil),       // 16: cluster.PubSubAutoRespondBatchTransport
-	(*PublishResponse)(nil),                       // 17: cluster.PublishResponse
-	(*actor.PID)(nil),                             // 18: actor.PID
-	(*ClusterIdentity)(nil),                       // 19: cluster.ClusterIdentity
-	(*durationpb.Duration)(nil),                   // 20: google.protobuf.Duration
+	(*SubscriptionFilter)(nil),                    // 3: cluster.SubscriptionFilter
+	(*Initialize)(nil),                            // 4: cluster.Initialize
+	(*Acknowledge)(nil),                           // 5: cluster.Acknowledge
+	(*Subscribers)(nil),                           // 6: cluster.Subscribers
+	(*SubscribeRequest)(nil),                      // 7: cluster.SubscribeRequest
+	(*SubscriptionStart)(nil),                     // 8: cluster.SubscriptionStart
+	(*SubscribeResponse)(nil),                     // 9: cluster.SubscribeResponse
+	(*UnsubscribeRequest)(nil),                    // 10: cluster.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),                   // 11: cluster.UnsubscribeResponse
+	(*PubSubBatchTransport)(nil),                  // 12: cluster.PubSubBatchTransport
+	(*PubSubEnvelope)(nil),                        // 13: cluster.PubSubEnvelope
+	(*PubSubDeadLetter)(nil),                      // 14: cluster.PubSubDeadLetter
+	(*PubSubPatterns)(nil),                        // 15: cluster.PubSubPatterns
+	(*DeliverBatchRequestTransport)(nil),          // 16: cluster.DeliverBatchRequestTransport
+	(*GroupDelivery)(nil),                         // 17: cluster.GroupDelivery
+	(*AcknowledgeDeliveryRequest)(nil),            // 18: cluster.AcknowledgeDeliveryRequest
+	(*AcknowledgeDeliveryResponse)(nil),           // 19: cluster.AcknowledgeDeliveryResponse
+	(*NotifyAboutFailingSubscribersRequest)(nil),  // 20: cluster.NotifyAboutFailingSubscribersRequest
+	(*NotifyAboutFailingSubscribersResponse)(nil), // 21: cluster.NotifyAboutFailingSubscribersResponse
+	(*SubscriberDeliveryReport)(nil),              // 22: cluster.SubscriberDeliveryReport
+	(*PubSubAutoRespondBatchTransport)(nil),       // 23: cluster.PubSubAutoRespondBatchTransport
+	(*PublishResponse)(nil),                       // 24: cluster.PublishResponse
+	nil,                                           // 25: cluster.SubscriptionFilter.HeadersEntry
+	nil,                                           // 26: cluster.PubSubEnvelope.HeadersEntry
+	nil,                                           // 27: cluster.PubSubDeadLetter.HeadersEntry
+	(*actor.PID)(nil),                             // 28: actor.PID
+	(*ClusterIdentity)(nil),                       // 29: cluster.ClusterIdentity
+	(*durationpb.Duration)(nil),                   // 30: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                 // 31: google.protobuf.Timestamp
 }
 var file_pubsub_proto_depIdxs = []int32{
-	18, // 0: cluster.SubscriberIdentity.pid:type_name -> actor.PID
-	19, // 1: cluster.SubscriberIdentity.cluster_identity:type_name -> cluster.ClusterIdentity
-	20, // 2: cluster.Initialize.idleTimeout:type_name -> google.protobuf.Duration
-	2,  // 3: cluster.Subscribers.subscribers:type_name -> cluster.SubscriberIdentity
-	2,  // 4: cluster.SubscribeRequest.subscriber:type_name -> cluster.SubscriberIdentity
-	2,  // 5: cluster.UnsubscribeRequest.subscriber:type_name -> cluster.SubscriberIdentity
-	11, // 6: cluster.PubSubBatchTransport.envelopes:type_name -> cluster.PubSubEnvelope
-	5,  // 7: cluster.DeliverBatchRequestTransport.subscribers:type_name -> cluster.Subscribers
-	10, // 8: cluster.DeliverBatchRequestTransport.batch:type_name -> cluster.PubSubBatchTransport
-	15, // 9: cluster.NotifyAboutFailingSubscribersRequest.invalid_deliveries:type_name -> cluster.SubscriberDeliveryReport
-	2,  // 10: cluster.SubscriberDeliveryReport.subscriber:type_name -> cluster.SubscriberIdentity
-	0,  // 11: cluster.SubscriberDeliveryReport.status:type_name -> cluster.DeliveryStatus
-	11, // 12: cluster.PubSubAutoRespondBatchTransport.envelopes:type_name -> cluster.PubSubEnvelope
-	1,  // 13: cluster.PublishResponse.status:type_name -> cluster.PublishStatus
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	28, // 0: cluster.SubscriberIdentity.pid:type_name -> actor.PID
+	29, // 1: cluster.SubscriberIdentity.cluster_identity:type_name -> cluster.ClusterIdentity
+	3,  // 2: cluster.SubscriberIdentity.filter:type_name -> cluster.SubscriptionFilter
+	25, // 3: cluster.SubscriptionFilter.headers:type_name -> cluster.SubscriptionFilter.HeadersEntry
+	30, // 4: cluster.Initialize.idleTimeout:type_name -> google.protobuf.Duration
+	2,  // 5: cluster.Subscribers.subscribers:type_name -> cluster.SubscriberIdentity
+	2,  // 6: cluster.SubscribeRequest.subscriber:type_name -> cluster.SubscriberIdentity
+	8,  // 7: cluster.SubscribeRequest.start:type_name -> cluster.SubscriptionStart
+	3,  // 8: cluster.SubscribeRequest.filter:type_name -> cluster.SubscriptionFilter
+	31, // 9: cluster.SubscriptionStart.time:type_name -> google.protobuf.Timestamp
+	2,  // 10: cluster.UnsubscribeRequest.subscriber:type_name -> cluster.SubscriberIdentity
+	13, // 11: cluster.PubSubBatchTransport.envelopes:type_name -> cluster.PubSubEnvelope
+	26, // 12: cluster.PubSubEnvelope.headers:type_name -> cluster.PubSubEnvelope.HeadersEntry
+	2,  // 13: cluster.PubSubDeadLetter.subscriber:type_name -> cluster.SubscriberIdentity
+	0,  // 14: cluster.PubSubDeadLetter.status:type_name -> cluster.DeliveryStatus
+	27, // 15: cluster.PubSubDeadLetter.headers:type_name -> cluster.PubSubDeadLetter.HeadersEntry
+	6,  // 16: cluster.DeliverBatchRequestTransport.subscribers:type_name -> cluster.Subscribers
+	12, // 17: cluster.DeliverBatchRequestTransport.batch:type_name -> cluster.PubSubBatchTransport
+	17, // 18: cluster.DeliverBatchRequestTransport.groups:type_name -> cluster.GroupDelivery
+	2,  // 19: cluster.GroupDelivery.candidates:type_name -> cluster.SubscriberIdentity
+	2,  // 20: cluster.AcknowledgeDeliveryRequest.subscribers:type_name -> cluster.SubscriberIdentity
+	22, // 21: cluster.NotifyAboutFailingSubscribersRequest.invalid_deliveries:type_name -> cluster.SubscriberDeliveryReport
+	2,  // 22: cluster.SubscriberDeliveryReport.subscriber:type_name -> cluster.SubscriberIdentity
+	0,  // 23: cluster.SubscriberDeliveryReport.status:type_name -> cluster.DeliveryStatus
+	13, // 24: cluster.PubSubAutoRespondBatchTransport.envelopes:type_name -> cluster.PubSubEnvelope
+	1,  // 25: cluster.PublishResponse.status:type_name -> cluster.PublishStatus
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_pubsub_proto_init() }
@@ -1139,7 +1803,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Initialize); i {
+			switch v := v.(*SubscriptionFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1151,7 +1815,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Acknowledge); i {
+			switch v := v.(*Initialize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1163,7 +1827,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subscribers); i {
+			switch v := v.(*Acknowledge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1175,7 +1839,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+			switch v := v.(*Subscribers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1187,7 +1851,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeResponse); i {
+			switch v := v.(*SubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1199,7 +1863,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeRequest); i {
+			switch v := v.(*SubscriptionStart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1211,7 +1875,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeResponse); i {
+			switch v := v.(*SubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1223,7 +1887,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubSubBatchTransport); i {
+			switch v := v.(*UnsubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1235,7 +1899,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubSubEnvelope); i {
+			switch v := v.(*UnsubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1247,7 +1911,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeliverBatchRequestTransport); i {
+			switch v := v.(*PubSubBatchTransport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1259,7 +1923,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyAboutFailingSubscribersRequest); i {
+			switch v := v.(*PubSubEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1271,7 +1935,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyAboutFailingSubscribersResponse); i {
+			switch v := v.(*PubSubDeadLetter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1283,7 +1947,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriberDeliveryReport); i {
+			switch v := v.(*PubSubPatterns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1295,7 +1959,7 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubSubAutoRespondBatchTransport); i {
+			switch v := v.(*DeliverBatchRequestTransport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1307,6 +1971,90 @@ func file_pubsub_proto_init() {
 			}
 		}
 		file_pubsub_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeDeliveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeDeliveryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyAboutFailingSubscribersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyAboutFailingSubscribersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriberDeliveryReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubSubAutoRespondBatchTransport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pubsub_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublishResponse); i {
 			case 0:
 				return &v.state
@@ -1323,13 +2071,17 @@ func file_pubsub_proto_init() {
 		(*SubscriberIdentity_Pid)(nil),
 		(*SubscriberIdentity_ClusterIdentity)(nil),
 	}
+	file_pubsub_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*SubscriptionStart_Beginning)(nil),
+		(*SubscriptionStart_Time)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pubsub_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   16,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
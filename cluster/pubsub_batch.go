@@ -18,18 +18,26 @@ var (
 
 type PubSubBatch struct {
 	Envelopes []proto.Message
+	Headers   []map[string]string // headers of the envelopes by index, subscription filters can match them. Optional
+}
+
+// Header returns the header of the envelope at the index, nil when it has none
+func (b *PubSubBatch) Header(index int) map[string]string {
+	if index < len(b.Headers) {
+		return b.Headers[index]
+	}
+	return nil
 }
 
 // Serialize converts a PubSubBatch to a PubSubBatchTransport.
-func (b *PubSubBatch) Serialize() (remote.RootSerialized, error) {
+func (b *PubSubBatch) Serialize(serializers *remote.SerializerRegistry) (remote.RootSerialized, error) {
 	batch := &PubSubBatchTransport{
 		TypeNames: make([]string, 0),
 		Envelopes: make([]*PubSubEnvelope, 0),
 	}
 
-	for _, envelope := range b.Envelopes {
-		var serializerId int32
-		messageData, typeName, err := remote.Serialize(envelope, serializerId)
+	for i, envelope := range b.Envelopes {
+		messageData, typeName, serializerId, err := serializers.Serialize(envelope)
 		if err != nil {
 			return nil, err
 		}
@@ -49,19 +57,20 @@ func (b *PubSubBatch) Serialize() (remote.RootSerialized, error) {
 			MessageData:  messageData,
 			TypeId:       int32(typeIndex),
 			SerializerId: serializerId,
+			Headers:      b.Header(i),
 		})
 	}
 	return batch, nil
 }
 
 // Deserialize converts a PubSubBatchTransport to a PubSubBatch.
-func (t *PubSubBatchTransport) Deserialize() (remote.RootSerializable, error) {
+func (t *PubSubBatchTransport) Deserialize(serializers *remote.SerializerRegistry) (remote.RootSerializable, error) {
 	b := &PubSubBatch{
 		Envelopes: make([]proto.Message, 0),
 	}
 
 	for _, envelope := range t.Envelopes {
-		message, err := remote.Deserialize(envelope.MessageData, t.TypeNames[envelope.TypeId], envelope.SerializerId)
+		message, err := serializers.Deserialize(envelope.MessageData, t.TypeNames[envelope.TypeId], envelope.SerializerId)
 		if err != nil {
 			return nil, err
 		}
@@ -71,6 +80,12 @@ func (t *PubSubBatchTransport) Deserialize() (remote.RootSerializable, error) {
 		}
 
 		b.Envelopes = append(b.Envelopes, protoMessage)
+		if len(envelope.Headers) > 0 {
+			if b.Headers == nil {
+				b.Headers = make([]map[string]string, len(t.Envelopes))
+			}
+			b.Headers[len(b.Envelopes)-1] = envelope.Headers
+		}
 	}
 	return b, nil
 }
@@ -79,10 +94,12 @@ type DeliverBatchRequest struct {
 	Subscribers *Subscribers
 	PubSubBatch *PubSubBatch
 	Topic       string
+	Offset      int64            // offset of the batch in the log of a durable topic, 0 when the topic is not durable
+	Groups      []*GroupDelivery // consumer groups the batch is delivered to, once per group
 }
 
-func (d *DeliverBatchRequest) Serialize() (remote.RootSerialized, error) {
-	rs, err := d.PubSubBatch.Serialize()
+func (d *DeliverBatchRequest) Serialize(serializers *remote.SerializerRegistry) (remote.RootSerialized, error) {
+	rs, err := d.PubSubBatch.Serialize(serializers)
 	if err != nil {
 		return nil, err
 	}
@@ -91,11 +108,13 @@ func (d *DeliverBatchRequest) Serialize() (remote.RootSerialized, error) {
 		Subscribers: d.Subscribers,
 		Batch:       rs.(*PubSubBatchTransport),
 		Topic:       d.Topic,
+		Offset:      d.Offset,
+		Groups:      d.Groups,
 	}, nil
 }
 
-func (t *DeliverBatchRequestTransport) Deserialize() (remote.RootSerializable, error) {
-	rs, err := t.Batch.Deserialize()
+func (t *DeliverBatchRequestTransport) Deserialize(serializers *remote.SerializerRegistry) (remote.RootSerializable, error) {
+	rs, err := t.Batch.Deserialize(serializers)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +123,8 @@ func (t *DeliverBatchRequestTransport) Deserialize() (remote.RootSerializable, e
 		Subscribers: t.Subscribers,
 		PubSubBatch: rs.(*PubSubBatch),
 		Topic:       t.Topic,
+		Offset:      t.Offset,
+		Groups:      t.Groups,
 	}, nil
 }
 
@@ -111,13 +132,14 @@ var _ actor.MessageBatch = (*PubSubAutoRespondBatch)(nil)
 
 type PubSubAutoRespondBatch struct {
 	Envelopes []proto.Message
+	Headers   []map[string]string // headers of the envelopes by index, the subscriber receives them as message headers. Optional
 }
 
 // Serialize converts a PubSubAutoRespondBatch to a PubSubAutoRespondBatchTransport.
-func (b *PubSubAutoRespondBatch) Serialize() (remote.RootSerialized, error) {
-	batch := &PubSubBatch{Envelopes: b.Envelopes}
+func (b *PubSubAutoRespondBatch) Serialize(serializers *remote.SerializerRegistry) (remote.RootSerialized, error) {
+	batch := &PubSubBatch{Envelopes: b.Envelopes, Headers: b.Headers}
 
-	rs, err := batch.Serialize()
+	rs, err := batch.Serialize(serializers)
 	if err != nil {
 		return nil, err
 	}
@@ -128,34 +150,48 @@ func (b *PubSubAutoRespondBatch) Serialize() (remote.RootSerialized, error) {
 	}, nil
 }
 
-// GetAutoResponse returns a PublishResponse.
-func (b *PubSubAutoRespondBatch) GetAutoResponse(_ actor.Context) interface{} {
+// batchResponder is implemented by the subscribers that decide whether they processed the batches they receive
+type batchResponder interface {
+	// batchResponse returns the response to the batch, after the subscriber received its messages
+	batchResponse() *PublishResponse
+}
+
+// GetAutoResponse returns a PublishResponse, failed when the subscriber failed to process the batch.
+func (b *PubSubAutoRespondBatch) GetAutoResponse(ctx actor.Context) interface{} {
+	if responder, ok := ctx.Actor().(batchResponder); ok {
+		return responder.batchResponse()
+	}
 	return &PublishResponse{
 		Status: PublishStatus_Ok,
 	}
 }
 
-// GetMessages returns the message.
+// GetMessages returns the message, in a MessageEnvelope carrying its header when it has one.
 func (b *PubSubAutoRespondBatch) GetMessages() []interface{} {
 	var messages []interface{}
-	for _, envelope := range b.Envelopes {
+	for i, envelope := range b.Envelopes {
+		if i < len(b.Headers) && len(b.Headers[i]) > 0 {
+			messages = append(messages, &actor.MessageEnvelope{Header: b.Headers[i], Message: envelope})
+			continue
+		}
 		messages = append(messages, envelope)
 	}
 	return messages
 }
 
 // Deserialize converts a PubSubAutoRespondBatchTransport to a PubSubAutoRespondBatch.
-func (t *PubSubAutoRespondBatchTransport) Deserialize() (remote.RootSerializable, error) {
+func (t *PubSubAutoRespondBatchTransport) Deserialize(serializers *remote.SerializerRegistry) (remote.RootSerializable, error) {
 	batch := &PubSubBatchTransport{
 		TypeNames: t.TypeNames,
 		Envelopes: t.Envelopes,
 	}
-	rs, err := batch.Deserialize()
+	rs, err := batch.Deserialize(serializers)
 	if err != nil {
 		return nil, err
 	}
 
 	return &PubSubAutoRespondBatch{
 		Envelopes: rs.(*PubSubBatch).Envelopes,
+		Headers:   rs.(*PubSubBatch).Headers,
 	}, nil
 }
@@ -0,0 +1,26 @@
+package cluster
+
+import (
+	"strings"
+
+	"github.com/asynkron/protoactor-go/remote"
+)
+
+// deadLetterTopicPrefix prefixes the name of the dead-letter topic of a topic
+const deadLetterTopicPrefix = "$deadletter."
+
+// DeadLetterTopic returns the name of the dead-letter topic of the topic. Subscribe to it to receive the
+// PubSubDeadLetter messages the subscribers of the topic failed to process.
+func DeadLetterTopic(topic string) string {
+	return deadLetterTopicPrefix + topic
+}
+
+// IsDeadLetterTopic reports whether the topic is the dead-letter topic of another topic
+func IsDeadLetterTopic(topic string) bool {
+	return strings.HasPrefix(topic, deadLetterTopicPrefix)
+}
+
+// Message deserializes the message the subscriber failed to process, with the serializers of the cluster's remote
+func (m *PubSubDeadLetter) Message(serializers *remote.SerializerRegistry) (interface{}, error) {
+	return serializers.Deserialize(m.MessageData, m.TypeName, m.SerializerId)
+}
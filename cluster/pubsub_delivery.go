@@ -1,83 +1,256 @@
 package cluster
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/remote"
+	"google.golang.org/protobuf/proto"
 )
 
+// ErrSubscriberFailed is the error of a delivery to a subscriber that responded it failed to process the batch
+var ErrSubscriberFailed = errors.New("subscriber failed to process the batch")
+
 type PubSubMemberDeliveryActor struct {
 	subscriberTimeout time.Duration
+	deliveryAttempts  int
+	deadLetterTopics  bool
 	shouldThrottle    actor.ShouldThrottle
 }
 
 func NewPubSubMemberDeliveryActor(subscriberTimeout time.Duration, logger *slog.Logger) *PubSubMemberDeliveryActor {
 	return &PubSubMemberDeliveryActor{
 		subscriberTimeout: subscriberTimeout,
+		deliveryAttempts:  1,
 		shouldThrottle: actor.NewThrottleWithLogger(logger, 10, time.Second, func(logger *slog.Logger, i int32) {
 			logger.Warn("[PubSubMemberDeliveryActor] Throttled logs", slog.Int("count", int(i)))
 		}),
 	}
 }
 
+// deliveryResult is the outcome of the delivery attempts of a batch to a subscriber
+type deliveryResult struct {
+	status   DeliveryStatus
+	err      error // error of the last attempt
+	attempts int32
+}
+
 func (p *PubSubMemberDeliveryActor) Receive(c actor.Context) {
 	if batch, ok := c.Message().(*DeliverBatchRequest); ok {
-		topicBatch := &PubSubAutoRespondBatch{Envelopes: batch.PubSubBatch.Envelopes}
-		siList := batch.Subscribers.Subscribers
+		siList := batch.Subscribers.GetSubscribers()
 
 		invalidDeliveries := make([]*SubscriberDeliveryReport, 0, len(siList))
+		delivered := make([]*SubscriberIdentity, 0, len(siList))
 
 		type futureWithIdentity struct {
 			future   *actor.Future
 			identity *SubscriberIdentity
+			batch    *PubSubBatch
 		}
 		futureList := make([]futureWithIdentity, 0, len(siList))
 		for _, identity := range siList {
-			f := p.DeliverBatch(c, topicBatch, identity)
+			// the filter of the subscriber applies before the batch leaves this member
+			subscriberBatch := filterBatch(batch.PubSubBatch, identity.Filter)
+			if len(subscriberBatch.Envelopes) == 0 {
+				delivered = append(delivered, identity)
+				continue
+			}
+			f := p.DeliverBatch(c, &PubSubAutoRespondBatch{Envelopes: subscriberBatch.Envelopes, Headers: subscriberBatch.Headers}, identity)
 			if f != nil {
-				futureList = append(futureList, futureWithIdentity{future: f, identity: identity})
+				futureList = append(futureList, futureWithIdentity{future: f, identity: identity, batch: subscriberBatch})
 			}
 		}
 
 		for _, fWithIdentity := range futureList {
-			_, err := fWithIdentity.future.Result()
-			identityLog := func(err error) {
-				if p.shouldThrottle() == actor.Open {
-					if fWithIdentity.identity.GetPid() != nil {
-						c.Logger().Error("Pub-sub message failed to deliver to PID", slog.String("pid", fWithIdentity.identity.GetPid().String()), slog.Any("error", err))
-					} else if fWithIdentity.identity.GetClusterIdentity() != nil {
-						c.Logger().Error("Pub-sub message failed to deliver to cluster identity", slog.String("cluster identity", fWithIdentity.identity.GetClusterIdentity().String()), slog.Any("error", err))
-					}
+			res, err := fWithIdentity.future.Result()
+			err = deliveryError(res, err)
+			result := p.retryDelivery(c, batch.Topic, fWithIdentity.batch, fWithIdentity.identity, err)
+			if result.status != DeliveryStatus_Delivered {
+				invalidDeliveries = append(invalidDeliveries, &SubscriberDeliveryReport{Status: result.status, Subscriber: fWithIdentity.identity})
+				if p.deadLetter(c, batch.Topic, fWithIdentity.batch, fWithIdentity.identity, result) {
+					// the subscriber is done with the batch, a durable topic does not send it again
+					delivered = append(delivered, fWithIdentity.identity)
 				}
+			} else {
+				delivered = append(delivered, fWithIdentity.identity)
 			}
+		}
 
-			status := DeliveryStatus_Delivered
-			if err != nil {
-				switch err {
-				case actor.ErrTimeout, remote.ErrTimeout:
-					identityLog(err)
-					status = DeliveryStatus_Timeout
-				case actor.ErrDeadLetter, remote.ErrDeadLetter:
-					identityLog(err)
-					status = DeliveryStatus_SubscriberNoLongerReachable
-				default:
-					identityLog(err)
-					status = DeliveryStatus_OtherError
-				}
-			}
-			if status != DeliveryStatus_Delivered {
-				invalidDeliveries = append(invalidDeliveries, &SubscriberDeliveryReport{Status: status, Subscriber: fWithIdentity.identity})
+		// each consumer group gets the batch once, from the first of its candidates that processes it
+		deliveredGroups := make([]string, 0, len(batch.Groups))
+		failedGroups := make([]string, 0)
+		for _, group := range batch.Groups {
+			reports, ok := p.DeliverToGroup(c, batch, group)
+			invalidDeliveries = append(invalidDeliveries, reports...)
+			if ok {
+				deliveredGroups = append(deliveredGroups, group.Group)
+			} else {
+				failedGroups = append(failedGroups, group.Group)
 			}
 		}
 
-		if len(invalidDeliveries) > 0 {
+		if batch.Offset > 0 && (len(delivered) > 0 || len(deliveredGroups) > 0) {
+			cluster := GetCluster(c.ActorSystem())
+			// the durable topic advances the offsets of the subscribers that processed the batch
+			_, _ = cluster.Request(batch.Topic, TopicActorKind, &AcknowledgeDeliveryRequest{Subscribers: delivered, Groups: deliveredGroups, Offset: batch.Offset})
+		}
+
+		if len(invalidDeliveries) > 0 || len(failedGroups) > 0 {
 			cluster := GetCluster(c.ActorSystem())
 			// we use cluster.Call to locate the topic actor in the cluster
-			_, _ = cluster.Request(batch.Topic, TopicActorKind, &NotifyAboutFailingSubscribersRequest{InvalidDeliveries: invalidDeliveries})
+			_, _ = cluster.Request(batch.Topic, TopicActorKind, &NotifyAboutFailingSubscribersRequest{InvalidDeliveries: invalidDeliveries, FailedGroups: failedGroups})
+		}
+	}
+}
+
+// retryDelivery delivers the batch to the subscriber again after the first attempt failed with the error,
+// as long as the failure is worth retrying and attempts remain. It records the outcome in the metrics of the topic.
+func (p *PubSubMemberDeliveryActor) retryDelivery(c actor.Context, topic string, batch *PubSubBatch, identity *SubscriberIdentity, err error) deliveryResult {
+	result := deliveryResult{status: p.deliveryStatus(c, identity, err), err: err, attempts: 1}
+	for int(result.attempts) < p.deliveryAttempts && isRetryable(result.status) {
+		f := p.DeliverBatch(c, &PubSubAutoRespondBatch{Envelopes: batch.Envelopes, Headers: batch.Headers}, identity)
+		if f == nil {
+			break
+		}
+		res, err := f.Result()
+		result.err = deliveryError(res, err)
+		result.status = p.deliveryStatus(c, identity, result.err)
+		result.attempts++
+	}
+
+	metrics := GetCluster(c.ActorSystem()).metrics
+	if result.status == DeliveryStatus_Delivered {
+		metrics.recordDelivered(topic, len(batch.Envelopes))
+	} else {
+		metrics.recordDeliveryFailure(topic, result.status)
+	}
+
+	return result
+}
+
+// deliveryError returns the error of a delivery the subscriber responded to with the response
+func deliveryError(res interface{}, err error) error {
+	if err != nil {
+		return err
+	}
+	if response, ok := res.(*PublishResponse); ok && response.Status == PublishStatus_Failed {
+		return ErrSubscriberFailed
+	}
+
+	return nil
+}
+
+// isRetryable reports whether a delivery that failed with the status can succeed when attempted again.
+// A subscriber that is no longer reachable will not process the batch.
+func isRetryable(status DeliveryStatus) bool {
+	return status == DeliveryStatus_Timeout || status == DeliveryStatus_OtherError
+}
+
+// deliveryStatus returns the status of the delivery to the subscriber that ended with the error, and logs the failure
+func (p *PubSubMemberDeliveryActor) deliveryStatus(c actor.Context, identity *SubscriberIdentity, err error) DeliveryStatus {
+	if err == nil {
+		return DeliveryStatus_Delivered
+	}
+
+	if p.shouldThrottle() == actor.Open {
+		if identity.GetPid() != nil {
+			c.Logger().Error("Pub-sub message failed to deliver to PID", slog.String("pid", identity.GetPid().String()), slog.Any("error", err))
+		} else if identity.GetClusterIdentity() != nil {
+			c.Logger().Error("Pub-sub message failed to deliver to cluster identity", slog.String("cluster identity", identity.GetClusterIdentity().String()), slog.Any("error", err))
+		}
+	}
+
+	switch err {
+	case actor.ErrTimeout, remote.ErrTimeout:
+		return DeliveryStatus_Timeout
+	case actor.ErrDeadLetter, remote.ErrDeadLetter:
+		return DeliveryStatus_SubscriberNoLongerReachable
+	default:
+		return DeliveryStatus_OtherError
+	}
+}
+
+// DeliverToGroup delivers the messages of the batch that pass their filters to the candidates of the consumer group in turn, until one processes it.
+// It returns the reports of the candidates that failed, and whether a candidate processed the batch. When all the candidates fail,
+// the batch is dead-lettered, if enabled, and the group is done with it.
+func (p *PubSubMemberDeliveryActor) DeliverToGroup(c actor.Context, batch *DeliverBatchRequest, group *GroupDelivery) ([]*SubscriberDeliveryReport, bool) {
+	var reports []*SubscriberDeliveryReport
+	var last *SubscriberIdentity
+	var lastBatch *PubSubBatch
+	var result deliveryResult
+	attempts := int32(0)
+	for _, candidate := range group.Candidates {
+		candidateBatch := filterBatch(batch.PubSubBatch, candidate.Filter)
+		if len(candidateBatch.Envelopes) == 0 {
+			return reports, true
+		}
+		f := p.DeliverBatch(c, &PubSubAutoRespondBatch{Envelopes: candidateBatch.Envelopes, Headers: candidateBatch.Headers}, candidate)
+		if f == nil {
+			continue
 		}
+
+		res, err := f.Result()
+		result = p.retryDelivery(c, batch.Topic, candidateBatch, candidate, deliveryError(res, err))
+		attempts += result.attempts
+		if result.status == DeliveryStatus_Delivered {
+			return reports, true
+		}
+		reports = append(reports, &SubscriberDeliveryReport{Status: result.status, Subscriber: candidate})
+		last, lastBatch = candidate, candidateBatch
 	}
+
+	if last != nil {
+		result.attempts = attempts
+		return reports, p.deadLetter(c, batch.Topic, lastBatch, last, result)
+	}
+
+	return reports, false
+}
+
+// deadLetter publishes the messages of the batch the subscriber failed to process to the dead-letter topic of the topic,
+// when dead-letter topics are enabled. It reports whether the messages were dead-lettered.
+func (p *PubSubMemberDeliveryActor) deadLetter(c actor.Context, topic string, batch *PubSubBatch, identity *SubscriberIdentity, result deliveryResult) bool {
+	// the messages a dead-letter topic fails to deliver are dropped
+	if !p.deadLetterTopics || IsDeadLetterTopic(topic) {
+		return false
+	}
+
+	reason := ""
+	if result.err != nil {
+		reason = result.err.Error()
+	}
+	cluster := GetCluster(c.ActorSystem())
+	deadLetters := &PubSubBatch{Envelopes: make([]proto.Message, 0, len(batch.Envelopes))}
+	for i, envelope := range batch.Envelopes {
+		data, typeName, serializerId, err := cluster.Remote.Serializers().Serialize(envelope)
+		if err != nil {
+			c.Logger().Error("Could not serialize dead-lettered pub-sub message", slog.String("topic", topic), slog.Any("error", err))
+			return false
+		}
+		deadLetters.Envelopes = append(deadLetters.Envelopes, &PubSubDeadLetter{
+			Topic:        topic,
+			Subscriber:   identity,
+			Status:       result.status,
+			Reason:       reason,
+			Attempts:     result.attempts,
+			TypeName:     typeName,
+			MessageData:  data,
+			SerializerId: serializerId,
+			Headers:      batch.Header(i),
+		})
+	}
+
+	// TODO: cancellation logic config?
+	res, err := cluster.Publisher().PublishBatch(context.Background(), DeadLetterTopic(topic), deadLetters)
+	if err != nil || res.Status == PublishStatus_Failed {
+		c.Logger().Error("Could not publish pub-sub messages to the dead-letter topic", slog.String("topic", topic), slog.Any("error", err))
+		return false
+	}
+
+	return true
 }
 
 // DeliverBatch delivers PubSubAutoRespondBatch to SubscriberIdentity.
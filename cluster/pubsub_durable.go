@@ -0,0 +1,261 @@
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// replayReadLimit is the number of log entries read at once when replaying a durable topic to a subscriber
+const replayReadLimit = 100
+
+// ackedAheadLimit is the number of batches acknowledged after a batch that was not, every time it is reached
+// the batch is considered lost and sent again, with the next batch
+const ackedAheadLimit = 100
+
+// StartFromBeginning replays all the batches a durable topic retains to the subscriber
+func StartFromBeginning() *SubscriptionStart {
+	return &SubscriptionStart{Position: &SubscriptionStart_Beginning{Beginning: true}}
+}
+
+// StartFromTime replays the batches a durable topic retains that were published at or after the time
+func StartFromTime(t time.Time) *SubscriptionStart {
+	return &SubscriptionStart{Position: &SubscriptionStart_Time{Time: timestamppb.New(t)}}
+}
+
+// key identifies the subscriber in the offsets of a durable topic
+func (s subscribeIdentityStruct) key() string {
+	if s.isPID {
+		return "pid/" + s.pid.address + "/" + s.pid.id
+	}
+
+	return "ci/" + s.clusterIdentity.kind + "/" + s.clusterIdentity.identity
+}
+
+// loadOffsets loads the acknowledged offsets, the subscribers without one get the next batches only
+func (t *TopicActor) loadOffsets(logger *slog.Logger) {
+	// TODO: cancellation logic config?
+	ctx := context.Background()
+	offsets, err := t.log.LoadOffsets(ctx, t.topic)
+	if err != nil {
+		logger.Error("Error when loading topic offsets", slog.String("topic", t.topic), slog.Any("error", err))
+	}
+	if offsets == nil {
+		offsets = map[string]int64{}
+	}
+	_, last, err := t.log.Bounds(ctx, t.topic)
+	if err != nil {
+		logger.Error("Error when loading topic log bounds", slog.String("topic", t.topic), slog.Any("error", err))
+	}
+
+	t.acked = offsets
+	for identityStruct, identity := range t.subscribers {
+		key := identityStruct.key()
+		if identity.Group != "" {
+			key = groupKey(identity.Group)
+		}
+		if _, ok := t.acked[key]; !ok {
+			t.acked[key] = last
+		}
+		t.sent[key] = t.acked[key]
+	}
+}
+
+// saveOffsets saves the acknowledged offsets of the topic
+func (t *TopicActor) saveOffsets(logger *slog.Logger) {
+	// TODO: cancellation logic config?
+	if err := t.log.SaveOffsets(context.Background(), t.topic, t.acked); err != nil && t.shouldThrottle() == actor.Open {
+		logger.Error("Error when saving topic offsets", slog.String("topic", t.topic), slog.Any("error", err))
+	}
+}
+
+// startSubscription positions the subscriber, or its consumer group, in the log. It returns the offset to replay
+// the batches the subscriber has not acknowledged yet up to, if there are any.
+func (t *TopicActor) startSubscription(c actor.Context, key string, start *SubscriptionStart) (int64, bool) {
+	// TODO: cancellation logic config?
+	ctx := context.Background()
+	first, last, err := t.log.Bounds(ctx, t.topic)
+	if err != nil {
+		c.Logger().Error("Error when loading topic log bounds", slog.String("topic", t.topic), slog.Any("error", err))
+		return 0, false
+	}
+
+	acked, resumed := t.acked[key]
+	switch {
+	case start.GetBeginning():
+		acked = first - 1
+	case start.GetTime() != nil:
+		offset, err := t.log.OffsetAt(ctx, t.topic, start.GetTime().AsTime())
+		if err != nil {
+			c.Logger().Error("Error when looking up topic offset", slog.String("topic", t.topic), slog.Any("error", err))
+			offset = last + 1
+		}
+		acked = offset - 1
+	case !resumed:
+		acked = last
+	default:
+		if _, sending := t.sent[key]; sending {
+			// the topic already delivers to the subscriber or to its group
+			return 0, false
+		}
+	}
+
+	t.acked[key] = acked
+	delete(t.ackedAhead, key)
+	t.sent[key] = acked
+	t.saveOffsets(c.Logger())
+
+	return last, acked < last
+}
+
+// replay sends the target the batches after the last one it was sent, up to the offset, one by one
+func (t *TopicActor) replay(c actor.Context, target deliveryTarget, to int64) {
+	// TODO: cancellation logic config?
+	ctx := context.Background()
+	key := target.key
+	from := t.sent[key] + 1
+
+	first, _, err := t.log.Bounds(ctx, t.topic)
+	if err != nil {
+		c.Logger().Error("Error when loading topic log bounds", slog.String("topic", t.topic), slog.Any("error", err))
+		return
+	}
+	if from < first {
+		// the log no longer retains the batches, the subscriber does not wait for them
+		c.Logger().Warn("Topic subscriber missed batches the log no longer retains", slog.String("topic", t.topic),
+			slog.String("subscriber", key), slog.Int64("from", from), slog.Int64("first", first))
+		from = first
+		if t.acked[key] < first-1 {
+			t.acked[key] = first - 1
+			t.compactAcked(key)
+			t.saveOffsets(c.Logger())
+		}
+	}
+
+	deliveryPid := actor.NewPID(target.pid.Address, PubSubDeliveryName)
+	for from <= to {
+		entries, err := t.log.Read(ctx, t.topic, from, replayReadLimit)
+		if err != nil {
+			c.Logger().Error("Error when reading topic log", slog.String("topic", t.topic), slog.Any("error", err))
+			return
+		}
+		if len(entries) == 0 {
+			break
+		}
+
+		for _, entry := range entries {
+			if entry.Offset > to {
+				break
+			}
+			if _, ok := t.ackedAhead[key][entry.Offset]; ok {
+				// the batch was processed already, the target waits for the ones before it
+				t.sent[key] = entry.Offset
+				continue
+			}
+			c.Send(deliveryPid, &DeliverBatchRequest{
+				Subscribers: target.request.Subscribers,
+				Groups:      target.request.Groups,
+				PubSubBatch: entry.Batch,
+				Topic:       t.topic,
+				Offset:      entry.Offset,
+			})
+			t.sent[key] = entry.Offset
+		}
+		from = entries[len(entries)-1].Offset + 1
+	}
+	if t.sent[key] < to {
+		t.sent[key] = to
+	}
+}
+
+// forgetSent stops tracking the batches sent to the subscriber that left, or to its group once the group is empty.
+// The acknowledged offset is kept, delivery resumes from it when the subscriber or the group subscribes again.
+func (t *TopicActor) forgetSent(identityStruct subscribeIdentityStruct, identity *SubscriberIdentity) {
+	if identity.GetGroup() == "" {
+		delete(t.sent, identityStruct.key())
+		return
+	}
+	if len(t.groupMembers(identity.Group)) == 0 {
+		delete(t.sent, groupKey(identity.Group))
+	}
+}
+
+// onAcknowledgeDelivery advances the offsets of the subscribers that processed the batch after the ones they acknowledged
+func (t *TopicActor) onAcknowledgeDelivery(c actor.Context, msg *AcknowledgeDeliveryRequest) {
+	if t.log != nil {
+		changed := false
+		keys := make([]string, 0, len(msg.Subscribers)+len(msg.Groups))
+		for _, subscriber := range msg.Subscribers {
+			keys = append(keys, newSubscribeIdentityStruct(subscriber).key())
+		}
+		for _, group := range msg.Groups {
+			keys = append(keys, groupKey(group))
+		}
+		for _, key := range keys {
+			if t.acknowledge(c, key, msg.Offset) {
+				changed = true
+			}
+		}
+		if changed {
+			t.saveOffsets(c.Logger())
+		}
+	}
+	c.Respond(&AcknowledgeDeliveryResponse{})
+}
+
+// acknowledge records the batch at the offset as processed by the subscriber, or by the consumer group whose batches
+// its members acknowledge in any order. It returns whether the offset acknowledged in a row advanced.
+func (t *TopicActor) acknowledge(c actor.Context, key string, offset int64) bool {
+	acked, ok := t.acked[key]
+	if !ok || offset <= acked {
+		return false
+	}
+	if offset == acked+1 {
+		t.acked[key] = offset
+		t.compactAcked(key)
+		return true
+	}
+
+	ahead, ok := t.ackedAhead[key]
+	if !ok {
+		ahead = map[int64]struct{}{}
+		t.ackedAhead[key] = ahead
+	}
+	ahead[offset] = struct{}{}
+	if _, sending := t.sent[key]; sending && len(ahead)%ackedAheadLimit == 0 {
+		// the acknowledgement of the batch got lost or its delivery failed unnoticed
+		c.Logger().Warn("Topic batch was not acknowledged, sending it again", slog.String("topic", t.topic),
+			slog.String("subscriber", key), slog.Int64("offset", acked+1))
+		t.sent[key] = acked
+	}
+
+	return false
+}
+
+// compactAcked advances the offset acknowledged in a row over the offsets acknowledged ahead of it
+func (t *TopicActor) compactAcked(key string) {
+	ahead, ok := t.ackedAhead[key]
+	if !ok {
+		return
+	}
+
+	for offset := range ahead {
+		if offset <= t.acked[key] {
+			delete(ahead, offset)
+		}
+	}
+	for {
+		next := t.acked[key] + 1
+		if _, ok := ahead[next]; !ok {
+			break
+		}
+		delete(ahead, next)
+		t.acked[key] = next
+	}
+	if len(ahead) == 0 {
+		delete(t.ackedAhead, key)
+	}
+}
@@ -14,6 +14,16 @@ func (c *Cluster) BatchingProducer(topic string, opts ...BatchingProducerConfigO
 	return NewBatchingProducer(c.Publisher(), topic, opts...)
 }
 
+// Subscribe subscribes to a PubSub topic with the subscriber, consumer group, start position and filter of the request.
+// The topic may be a pattern such as orders.* or orders.>, to subscribe to all the topics matching it.
+func (c *Cluster) Subscribe(topic string, request *SubscribeRequest, opts ...GrainCallOption) (*SubscribeResponse, error) {
+	res, err := c.Request(topic, TopicActorKind, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*SubscribeResponse), err
+}
+
 // SubscribeByPid subscribes to a PubSub topic by subscriber PID
 func (c *Cluster) SubscribeByPid(topic string, pid *actor.PID, opts ...GrainCallOption) (*SubscribeResponse, error) {
 	res, err := c.Request(topic, TopicActorKind, &SubscribeRequest{
@@ -36,6 +46,56 @@ func (c *Cluster) SubscribeByClusterIdentity(topic string, identity *ClusterIden
 	return res.(*SubscribeResponse), err
 }
 
+// SubscribeByPidFrom subscribes to a durable PubSub topic by subscriber PID, replaying the retained batches from the start
+func (c *Cluster) SubscribeByPidFrom(topic string, pid *actor.PID, start *SubscriptionStart, opts ...GrainCallOption) (*SubscribeResponse, error) {
+	res, err := c.Request(topic, TopicActorKind, &SubscribeRequest{
+		Subscriber: &SubscriberIdentity{Identity: &SubscriberIdentity_Pid{Pid: pid}},
+		Start:      start,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*SubscribeResponse), err
+}
+
+// SubscribeByClusterIdentityFrom subscribes to a durable PubSub topic by cluster identity, replaying the retained batches from the start
+func (c *Cluster) SubscribeByClusterIdentityFrom(topic string, identity *ClusterIdentity, start *SubscriptionStart, opts ...GrainCallOption) (*SubscribeResponse, error) {
+	res, err := c.Request(topic, TopicActorKind, &SubscribeRequest{
+		Subscriber: &SubscriberIdentity{Identity: &SubscriberIdentity_ClusterIdentity{ClusterIdentity: identity}},
+		Start:      start,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*SubscribeResponse), err
+}
+
+// SubscribeByPidToGroup subscribes to a PubSub topic by subscriber PID in a consumer group, each batch published
+// to the topic goes to one subscriber of the group, and to another one when it fails to process it
+func (c *Cluster) SubscribeByPidToGroup(topic string, group string, pid *actor.PID, opts ...GrainCallOption) (*SubscribeResponse, error) {
+	res, err := c.Request(topic, TopicActorKind, &SubscribeRequest{
+		Subscriber: &SubscriberIdentity{Identity: &SubscriberIdentity_Pid{Pid: pid}},
+		Group:      group,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*SubscribeResponse), err
+}
+
+// SubscribeByClusterIdentityToGroup subscribes to a PubSub topic by cluster identity in a consumer group, each batch
+// published to the topic goes to one subscriber of the group, and to another one when it fails to process it
+func (c *Cluster) SubscribeByClusterIdentityToGroup(topic string, group string, identity *ClusterIdentity, opts ...GrainCallOption) (*SubscribeResponse, error) {
+	res, err := c.Request(topic, TopicActorKind, &SubscribeRequest{
+		Subscriber: &SubscriberIdentity{Identity: &SubscriberIdentity_ClusterIdentity{ClusterIdentity: identity}},
+		Group:      group,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*SubscribeResponse), err
+}
+
 // SubscribeWithReceive subscribe to a PubSub topic by providing a Receive function, that will be used to spawn a subscriber actor
 func (c *Cluster) SubscribeWithReceive(topic string, receive actor.ReceiveFunc, opts ...GrainCallOption) (*SubscribeResponse, error) {
 	props := actor.PropsFromFunc(receive)
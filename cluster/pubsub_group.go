@@ -0,0 +1,68 @@
+package cluster
+
+import (
+	"sort"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// groupKey identifies the consumer group in the offsets of a durable topic, the subscribers of a group share its offset
+func groupKey(group string) string {
+	return "group/" + group
+}
+
+// groupMembers returns the subscribers of the consumer group, in a stable order
+func (t *TopicActor) groupMembers(group string) []*SubscriberIdentity {
+	type member struct {
+		key      string
+		identity *SubscriberIdentity
+	}
+
+	members := make([]member, 0)
+	for identityStruct, identity := range t.subscribers {
+		if identity.Group == group {
+			members = append(members, member{key: identityStruct.key(), identity: identity})
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].key < members[j].key })
+
+	identities := make([]*SubscriberIdentity, len(members))
+	for i, m := range members {
+		identities[i] = m.identity
+	}
+
+	return identities
+}
+
+// groupTarget returns the target delivering the next batch to the consumer group. The subscribers of the group
+// take turns, the others are the candidates the batch is redelivered to when the subscriber fails to process it.
+func (t *TopicActor) groupTarget(c actor.Context, group string) (deliveryTarget, bool) {
+	members := t.groupMembers(group)
+	if len(members) == 0 {
+		return deliveryTarget{}, false
+	}
+
+	turn := t.groupTurns[group] % len(members)
+	t.groupTurns[group]++
+
+	candidates := make([]*SubscriberIdentity, 0, len(members))
+	candidates = append(candidates, members[turn:]...)
+	candidates = append(candidates, members[:turn]...)
+
+	for i, candidate := range candidates {
+		pid := t.getPID(c, candidate)
+		if pid == nil {
+			continue
+		}
+
+		// the first candidate that can be located goes first, the delivery actor on its member tries the others
+		ordered := append(candidates[i:len(candidates):len(candidates)], candidates[:i]...)
+		return deliveryTarget{
+			key:     groupKey(group),
+			pid:     pid,
+			request: &DeliverBatchRequest{Groups: []*GroupDelivery{{Group: group, Candidates: ordered}}},
+		}, true
+	}
+
+	return deliveryTarget{}, false
+}
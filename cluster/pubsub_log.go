@@ -0,0 +1,167 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TopicLogEntry is a batch published to a durable topic, at its offset in the log of the topic
+type TopicLogEntry struct {
+	Offset    int64
+	Timestamp time.Time
+	Batch     *PubSubBatch
+}
+
+// TopicLog retains the batches published to durable topics, and the offsets their subscribers acknowledged
+type TopicLog interface {
+	// Append the batch to the log of the topic and return its offset. Offsets start at 1 and grow by one per batch.
+	Append(ctx context.Context, topic string, batch *PubSubBatch, timestamp time.Time) (int64, error)
+	// Read at most limit retained entries of the topic, starting at the offset.
+	Read(ctx context.Context, topic string, from int64, limit int) ([]*TopicLogEntry, error)
+	// Bounds returns the first retained and the last appended offset of the topic, first is last+1 when nothing is retained.
+	Bounds(ctx context.Context, topic string) (first int64, last int64, err error)
+	// OffsetAt returns the offset of the first retained entry of the topic published at or after the time.
+	OffsetAt(ctx context.Context, topic string, timestamp time.Time) (int64, error)
+	// SaveOffsets saves the offsets the subscribers of the topic acknowledged, by subscriber key.
+	SaveOffsets(ctx context.Context, topic string, offsets map[string]int64) error
+	// LoadOffsets loads the offsets the subscribers of the topic acknowledged, by subscriber key.
+	LoadOffsets(ctx context.Context, topic string) (map[string]int64, error)
+}
+
+// InMemoryTopicLog is a TopicLog kept in the memory of the member, it does not survive the member.
+// It retains at most maxEntries entries per topic, for at most maxAge. A zero limit does not apply.
+type InMemoryTopicLog struct {
+	maxEntries int
+	maxAge     time.Duration
+
+	mutex   sync.RWMutex
+	topics  map[string]*inMemoryTopic
+	offsets map[string]map[string]int64
+}
+
+var _ TopicLog = (*InMemoryTopicLog)(nil)
+
+type inMemoryTopic struct {
+	entries []*TopicLogEntry
+	last    int64
+}
+
+// NewInMemoryTopicLog creates a TopicLog retaining at most maxEntries entries per topic, for at most maxAge
+func NewInMemoryTopicLog(maxEntries int, maxAge time.Duration) *InMemoryTopicLog {
+	return &InMemoryTopicLog{
+		maxEntries: maxEntries,
+		maxAge:     maxAge,
+		topics:     map[string]*inMemoryTopic{},
+		offsets:    map[string]map[string]int64{},
+	}
+}
+
+func (l *InMemoryTopicLog) Append(_ context.Context, topic string, batch *PubSubBatch, timestamp time.Time) (int64, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	t, ok := l.topics[topic]
+	if !ok {
+		t = &inMemoryTopic{}
+		l.topics[topic] = t
+	}
+
+	t.last++
+	t.entries = append(t.entries, &TopicLogEntry{Offset: t.last, Timestamp: timestamp, Batch: batch})
+	l.retain(t, timestamp)
+
+	return t.last, nil
+}
+
+// retain drops the entries beyond the retention of the log
+func (l *InMemoryTopicLog) retain(t *inMemoryTopic, now time.Time) {
+	drop := 0
+	if l.maxEntries > 0 && len(t.entries) > l.maxEntries {
+		drop = len(t.entries) - l.maxEntries
+	}
+	if l.maxAge > 0 {
+		for drop < len(t.entries) && now.Sub(t.entries[drop].Timestamp) > l.maxAge {
+			drop++
+		}
+	}
+	if drop > 0 {
+		t.entries = append([]*TopicLogEntry(nil), t.entries[drop:]...)
+	}
+}
+
+func (l *InMemoryTopicLog) Read(_ context.Context, topic string, from int64, limit int) ([]*TopicLogEntry, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	t, ok := l.topics[topic]
+	if !ok {
+		return nil, nil
+	}
+
+	start := sort.Search(len(t.entries), func(i int) bool { return t.entries[i].Offset >= from })
+	end := len(t.entries)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+
+	return append([]*TopicLogEntry(nil), t.entries[start:end]...), nil
+}
+
+func (l *InMemoryTopicLog) Bounds(_ context.Context, topic string) (int64, int64, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	t, ok := l.topics[topic]
+	if !ok {
+		return 1, 0, nil
+	}
+	if len(t.entries) == 0 {
+		return t.last + 1, t.last, nil
+	}
+
+	return t.entries[0].Offset, t.last, nil
+}
+
+func (l *InMemoryTopicLog) OffsetAt(_ context.Context, topic string, timestamp time.Time) (int64, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	t, ok := l.topics[topic]
+	if !ok {
+		return 1, nil
+	}
+
+	i := sort.Search(len(t.entries), func(i int) bool { return !t.entries[i].Timestamp.Before(timestamp) })
+	if i == len(t.entries) {
+		return t.last + 1, nil
+	}
+
+	return t.entries[i].Offset, nil
+}
+
+func (l *InMemoryTopicLog) SaveOffsets(_ context.Context, topic string, offsets map[string]int64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	saved := make(map[string]int64, len(offsets))
+	for key, offset := range offsets {
+		saved[key] = offset
+	}
+	l.offsets[topic] = saved
+
+	return nil
+}
+
+func (l *InMemoryTopicLog) LoadOffsets(_ context.Context, topic string) (map[string]int64, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	offsets := make(map[string]int64, len(l.offsets[topic]))
+	for key, offset := range l.offsets[topic] {
+		offsets[key] = offset
+	}
+
+	return offsets, nil
+}
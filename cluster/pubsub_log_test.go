@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryTopicLog_RetainsAndReadsEntries(t *testing.T) {
+	ctx := context.Background()
+	log := NewInMemoryTopicLog(3, 0)
+	start := time.Now()
+
+	first, last, err := log.Bounds(ctx, "topic")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first)
+	assert.Equal(t, int64(0), last)
+
+	for i := 0; i < 5; i++ {
+		offset, err := log.Append(ctx, "topic", &PubSubBatch{}, start.Add(time.Duration(i)*time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, int64(i+1), offset)
+	}
+
+	first, last, err = log.Bounds(ctx, "topic")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), first)
+	assert.Equal(t, int64(5), last)
+
+	entries, err := log.Read(ctx, "topic", 1, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(3), entries[0].Offset)
+	assert.Equal(t, int64(4), entries[1].Offset)
+
+	offset, err := log.OffsetAt(ctx, "topic", start.Add(3500*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), offset)
+	offset, err = log.OffsetAt(ctx, "topic", start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), offset)
+}
+
+func TestInMemoryTopicLog_DropsEntriesOlderThanMaxAge(t *testing.T) {
+	ctx := context.Background()
+	log := NewInMemoryTopicLog(0, time.Minute)
+	start := time.Now()
+
+	_, _ = log.Append(ctx, "topic", &PubSubBatch{}, start)
+	_, _ = log.Append(ctx, "topic", &PubSubBatch{}, start.Add(2*time.Minute))
+
+	first, last, err := log.Bounds(ctx, "topic")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), first)
+	assert.Equal(t, int64(2), last)
+}
+
+func TestInMemoryTopicLog_SavesOffsets(t *testing.T) {
+	ctx := context.Background()
+	log := NewInMemoryTopicLog(0, 0)
+
+	offsets, err := log.LoadOffsets(ctx, "topic")
+	require.NoError(t, err)
+	assert.Empty(t, offsets)
+
+	require.NoError(t, log.SaveOffsets(ctx, "topic", map[string]int64{"a": 3}))
+	offsets, err = log.LoadOffsets(ctx, "topic")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": int64(3)}, offsets)
+}
@@ -68,6 +68,7 @@ type BatchingProducer struct {
 	loopCancel       context.CancelFunc
 	loopDone         chan struct{}
 	msgLeft          uint32
+	metrics          *clusterMetrics
 }
 
 func NewBatchingProducer(publisher Publisher, topic string, opts ...BatchingProducerConfigOption) *BatchingProducer {
@@ -79,6 +80,9 @@ func NewBatchingProducer(publisher Publisher, topic string, opts ...BatchingProd
 		msgLeft:   0,
 		loopDone:  make(chan struct{}),
 	}
+	if publisher, ok := publisher.(*defaultPublisher); ok {
+		p.metrics = publisher.cluster.metrics
+	}
 	if config.MaxQueueSize > 0 {
 		p.publisherChannel = newBoundedChannel[produceMessage](config.MaxQueueSize)
 	} else {
@@ -104,6 +108,21 @@ func newPubSubBatchWithReceipts() *pubsubBatchWithReceipts {
 	}
 }
 
+// add adds the message to the batch, with the trace context it was produced with in its header
+func (b *pubsubBatchWithReceipts) add(msg produceMessage) {
+	traced := injectTraceContext(msg.ctx, &PubSubBatch{Envelopes: []proto.Message{msg.message}})
+	if header := traced.Header(0); header != nil {
+		if b.batch.Headers == nil {
+			b.batch.Headers = make([]map[string]string, len(b.batch.Envelopes), cap(b.batch.Envelopes))
+		}
+		b.batch.Headers = append(b.batch.Headers, header)
+	} else if b.batch.Headers != nil {
+		b.batch.Headers = append(b.batch.Headers, nil)
+	}
+	b.batch.Envelopes = append(b.batch.Envelopes, msg.message)
+	b.ctxArr = append(b.ctxArr, msg.ctx)
+}
+
 type produceMessage struct {
 	message proto.Message
 	ctx     context.Context
@@ -189,6 +208,7 @@ func (p *BatchingProducer) Produce(ctx context.Context, message proto.Message) (
 		}
 		return info, &ProducerQueueFullException{topic: p.topic}
 	}
+	p.metrics.recordProducerQueued(p.topic, 1)
 	return info, nil
 }
 
@@ -221,14 +241,14 @@ loop:
 			break loop
 		default:
 			if msg, ok := p.publisherChannel.tryRead(); ok {
+				p.metrics.recordProducerQueued(p.topic, -1)
 
 				// if msg ctx not done
 				select {
 				case <-msg.ctx.Done():
 					p.getProduceProcessInfo(msg.ctx).cancel()
 				default:
-					batchWrapper.batch.Envelopes = append(batchWrapper.batch.Envelopes, msg.message)
-					batchWrapper.ctxArr = append(batchWrapper.ctxArr, msg.ctx)
+					batchWrapper.add(msg)
 				}
 
 				if len(batchWrapper.batch.Envelopes) < p.config.BatchSize {
@@ -262,6 +282,7 @@ loop:
 func (p *BatchingProducer) cancelPendingMessages() {
 	for {
 		if msg, ok := p.publisherChannel.tryRead(); ok {
+			p.metrics.recordProducerQueued(p.topic, -1)
 			p.getProduceProcessInfo(msg.ctx).cancel()
 		} else {
 			break
@@ -283,6 +304,7 @@ func (p *BatchingProducer) cancelBatch(batchWrapper *pubsubBatchWithReceipts) {
 func (p *BatchingProducer) failPendingMessages(err error) {
 	for {
 		if msg, ok := p.publisherChannel.tryRead(); ok {
+			p.metrics.recordProducerQueued(p.topic, -1)
 			p.getProduceProcessInfo(msg.ctx).setErr(err)
 		} else {
 			break
@@ -330,6 +352,9 @@ func (p *BatchingProducer) removeCancelledFromBatch(batchWrapper *pubsubBatchWit
 			}
 
 			batchWrapper.batch.Envelopes = append(batchWrapper.batch.Envelopes[:i], batchWrapper.batch.Envelopes[i+1:]...)
+			if i < len(batchWrapper.batch.Headers) {
+				batchWrapper.batch.Headers = append(batchWrapper.batch.Headers[:i], batchWrapper.batch.Headers[i+1:]...)
+			}
 			batchWrapper.ctxArr = append(batchWrapper.ctxArr[:i], batchWrapper.ctxArr[i+1:]...)
 		default:
 			continue
@@ -5,6 +5,9 @@ import (
 	"log/slog"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -60,10 +63,20 @@ func (p *defaultPublisher) PublishBatch(ctx context.Context, topic string, batch
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
+		ctx, span := pubSubTracer().Start(ctx, topic+" publish", trace.WithSpanKind(trace.SpanKindProducer),
+			trace.WithAttributes(attribute.String("messaging.destination.name", topic), attribute.Int("messaging.batch.message_count", len(batch.Envelopes))))
+		defer span.End()
+
+		// the subscribers continue the trace of the messages from their headers
+		batch = injectTraceContext(ctx, batch)
+		start := time.Now()
 		res, err := p.cluster.Request(topic, TopicActorKind, batch, opts...)
 		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			return nil, err
 		}
+		p.cluster.metrics.recordPublish(topic, len(batch.Envelopes), time.Since(start))
 		return res.(*PublishResponse), err
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/eventstream"
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
 )
 
 const TopicActorKind = "prototopic"
@@ -19,18 +20,38 @@ type TopicActor struct {
 	subscriptionStore    KeyValueStore[*Subscribers]
 	topologySubscription *eventstream.Subscription
 	shouldThrottle       actor.ShouldThrottle
+
+	groupTurns map[string]int // number of batches delivered to each consumer group, to take turns among its subscribers
+
+	log        TopicLog                      // retains the batches of a durable topic, nil when the topic is not durable
+	acked      map[string]int64              // last offset each subscriber acknowledged in a row, by subscriber key
+	ackedAhead map[string]map[int64]struct{} // offsets acknowledged after a gap in the ones acknowledged in a row, by subscriber key
+	sent       map[string]int64              // last offset sent to each subscriber, by subscriber key
 }
 
 func NewTopicActor(store KeyValueStore[*Subscribers], logger *slog.Logger) *TopicActor {
 	return &TopicActor{
 		subscriptionStore: store,
 		subscribers:       make(map[subscribeIdentityStruct]*SubscriberIdentity),
+		groupTurns:        make(map[string]int),
 		shouldThrottle: actor.NewThrottleWithLogger(logger, 10, time.Second, func(logger *slog.Logger, count int32) {
 			logger.Info("[TopicActor] Throttled logs", slog.Int("count", int(count)))
 		}),
 	}
 }
 
+// NewDurableTopicActor creates a topic actor appending the published batches to the log, and replaying them
+// to the subscribers from the offset they acknowledged last, when they come back or ask for older batches
+func NewDurableTopicActor(store KeyValueStore[*Subscribers], log TopicLog, logger *slog.Logger) *TopicActor {
+	t := NewTopicActor(store, logger)
+	t.log = log
+	t.acked = map[string]int64{}
+	t.ackedAhead = map[string]map[int64]struct{}{}
+	t.sent = map[string]int64{}
+
+	return t
+}
+
 func (t *TopicActor) Receive(c actor.Context) {
 	switch msg := c.Message().(type) {
 	case *actor.Started:
@@ -49,6 +70,8 @@ func (t *TopicActor) Receive(c actor.Context) {
 		t.onPubSubBatch(c, msg)
 	case *NotifyAboutFailingSubscribersRequest:
 		t.onNotifyAboutFailingSubscribers(c, msg)
+	case *AcknowledgeDeliveryRequest:
+		t.onAcknowledgeDelivery(c, msg)
 	case *ClusterTopology:
 		t.onClusterTopologyChanged(c, msg)
 	}
@@ -69,11 +92,22 @@ func (t *TopicActor) onStarted(c actor.Context) {
 		}
 	}
 	t.unsubscribeSubscribersOnMembersThatLeft(c)
+	if t.log != nil {
+		t.loadOffsets(c.Logger())
+	}
+	t.subscribersChanged(c)
 
 	c.Logger().Debug("Topic started", slog.String("topic", t.topic))
 }
 
+// subscribersChanged keeps the registration of a wildcard topic and the subscriber count of the topic up to date
+func (t *TopicActor) subscribersChanged(c actor.Context) {
+	t.updatePatternRegistration(c, len(t.subscribers) > 0)
+	GetCluster(c.ActorSystem()).metrics.setSubscriberCount(t.topic, len(t.subscribers))
+}
+
 func (t *TopicActor) onStopping(c actor.Context) {
+	GetCluster(c.ActorSystem()).metrics.setSubscriberCount(t.topic, -1)
 	if t.topologySubscription != nil {
 		c.ActorSystem().EventStream.Unsubscribe(t.topologySubscription)
 		t.topologySubscription = nil
@@ -94,43 +128,102 @@ func (t *TopicActor) onInitialize(c actor.Context, msg *Initialize) {
 	c.Respond(&Acknowledge{})
 }
 
-type pidAndSubscriber struct {
-	pid        *actor.PID
-	subscriber *SubscriberIdentity
-}
-
 // onPubSubBatch handles a PubSubBatch message, sends the message to all subscribers
 func (t *TopicActor) onPubSubBatch(c actor.Context, batch *PubSubBatch) {
-	// map subscribers to map[address][](pid, subscriber)
-	members := make(map[string][]pidAndSubscriber)
-	for _, identity := range t.subscribers {
-		pid := t.getPID(c, identity)
-		if pid != nil {
-			members[pid.Address] = append(members[pid.Address], pidAndSubscriber{pid: pid, subscriber: identity})
+	var offset int64
+	if t.log != nil {
+		var err error
+		// TODO: cancellation logic config?
+		offset, err = t.log.Append(context.Background(), t.topic, batch, time.Now())
+		if err != nil {
+			c.Logger().Error("Error when appending batch to topic log", slog.String("topic", t.topic), slog.Any("error", err))
+			c.Respond(&PublishResponse{Status: PublishStatus_Failed})
+			return
 		}
 	}
 
-	// send message to each member
-	for address, member := range members {
-		subscribersOnMember := t.getSubscribersForAddress(member)
-		deliveryMessage := &DeliverBatchRequest{
-			Subscribers: subscribersOnMember,
-			PubSubBatch: batch,
-			Topic:       t.topic,
+	t.forwardToPatterns(c, batch)
+
+	// map subscribers and consumer groups to map[address]request
+	members := make(map[string]*DeliverBatchRequest)
+	for _, target := range t.targets(c) {
+		if t.log != nil {
+			sent, ok := t.sent[target.key]
+			if ok && sent < offset-1 {
+				// the target missed batches, it gets them in order before this one
+				t.replay(c, target, offset)
+				continue
+			}
+			if !ok {
+				t.acked[target.key] = offset - 1
+				delete(t.ackedAhead, target.key)
+			}
+			t.sent[target.key] = offset
+		}
+
+		request, ok := members[target.pid.Address]
+		if !ok {
+			request = &DeliverBatchRequest{
+				Subscribers: &Subscribers{},
+				PubSubBatch: batch,
+				Topic:       t.topic,
+				Offset:      offset,
+			}
+			members[target.pid.Address] = request
 		}
+		request.Subscribers.Subscribers = append(request.Subscribers.Subscribers, target.request.Subscribers.GetSubscribers()...)
+		request.Groups = append(request.Groups, target.request.Groups...)
+	}
+
+	// send message to each member
+	for address, deliveryMessage := range members {
 		deliveryPid := actor.NewPID(address, PubSubDeliveryName)
 		c.Send(deliveryPid, deliveryMessage)
 	}
 	c.Respond(&PublishResponse{})
 }
 
-// getSubscribersForAddress returns the subscribers for the given member list
-func (t *TopicActor) getSubscribersForAddress(members []pidAndSubscriber) *Subscribers {
-	subscribers := make([]*SubscriberIdentity, len(members))
-	for i, member := range members {
-		subscribers[i] = member.subscriber
+// deliveryTarget is a subscriber, or a consumer group, the topic delivers its batches to
+type deliveryTarget struct {
+	key     string               // key of the subscriber or of the group in the offsets of a durable topic
+	pid     *actor.PID           // PID of the subscriber, or of the first candidate of the group
+	request *DeliverBatchRequest // subscriber or group to deliver to, without the batch
+}
+
+// targets returns the subscribers that get every batch, and the consumer groups
+func (t *TopicActor) targets(c actor.Context) []deliveryTarget {
+	targets := make([]deliveryTarget, 0, len(t.subscribers))
+	groups := make(map[string]struct{})
+	for identityStruct, identity := range t.subscribers {
+		if identity.Group != "" {
+			groups[identity.Group] = struct{}{}
+			continue
+		}
+		if target, ok := t.subscriberTarget(c, identityStruct, identity); ok {
+			targets = append(targets, target)
+		}
 	}
-	return &Subscribers{Subscribers: subscribers}
+	for group := range groups {
+		if target, ok := t.groupTarget(c, group); ok {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
+
+// subscriberTarget returns the target delivering to the subscriber, if it can be located
+func (t *TopicActor) subscriberTarget(c actor.Context, identityStruct subscribeIdentityStruct, identity *SubscriberIdentity) (deliveryTarget, bool) {
+	pid := t.getPID(c, identity)
+	if pid == nil {
+		return deliveryTarget{}, false
+	}
+
+	return deliveryTarget{
+		key:     identityStruct.key(),
+		pid:     pid,
+		request: &DeliverBatchRequest{Subscribers: &Subscribers{Subscribers: []*SubscriberIdentity{identity}}},
+	}, true
 }
 
 // getPID returns the PID of the subscriber
@@ -154,6 +247,21 @@ func (t *TopicActor) getClusterIdentityPid(c actor.Context, identity *ClusterIde
 // onNotifyAboutFailingSubscribers handles a NotifyAboutFailingSubscribersRequest message
 func (t *TopicActor) onNotifyAboutFailingSubscribers(c actor.Context, msg *NotifyAboutFailingSubscribersRequest) {
 	t.unsubscribeUnreachablePidSubscribers(c, msg.InvalidDeliveries)
+	if t.log != nil {
+		// the failed batches are sent again with the next batch
+		keys := make([]string, 0, len(msg.InvalidDeliveries)+len(msg.FailedGroups))
+		for _, report := range msg.InvalidDeliveries {
+			keys = append(keys, newSubscribeIdentityStruct(report.Subscriber).key())
+		}
+		for _, group := range msg.FailedGroups {
+			keys = append(keys, groupKey(group))
+		}
+		for _, key := range keys {
+			if _, ok := t.sent[key]; ok {
+				t.sent[key] = t.acked[key]
+			}
+		}
+	}
 	t.logDeliveryErrors(msg.InvalidDeliveries, c.Logger())
 	c.Respond(&NotifyAboutFailingSubscribersResponse{})
 }
@@ -170,14 +278,14 @@ func (t *TopicActor) logDeliveryErrors(reports []*SubscriberDeliveryReport, logg
 }
 
 // unsubscribeUnreachablePidSubscribers deletes all subscribers that have a PID that is unreachable
-func (t *TopicActor) unsubscribeUnreachablePidSubscribers(_ actor.Context, allInvalidDeliveryReports []*SubscriberDeliveryReport) {
+func (t *TopicActor) unsubscribeUnreachablePidSubscribers(c actor.Context, allInvalidDeliveryReports []*SubscriberDeliveryReport) {
 	subscribers := make([]subscribeIdentityStruct, 0, len(allInvalidDeliveryReports))
 	for _, r := range allInvalidDeliveryReports {
 		if r.Subscriber.GetPid() != nil && r.Status == DeliveryStatus_SubscriberNoLongerReachable {
 			subscribers = append(subscribers, newSubscribeIdentityStruct(r.Subscriber))
 		}
 	}
-	t.removeSubscribers(subscribers, nil)
+	t.removeSubscribers(c, subscribers)
 }
 
 // onClusterTopologyChanged handles a ClusterTopology message
@@ -197,7 +305,7 @@ func (t *TopicActor) onClusterTopologyChanged(ctx actor.Context, msg *ClusterTop
 				}
 			}
 		}
-		t.removeSubscribers(subscribersThatLeft, ctx.Logger())
+		t.removeSubscribers(ctx, subscribersThatLeft)
 	}
 }
 
@@ -217,19 +325,25 @@ func (t *TopicActor) unsubscribeSubscribersOnMembersThatLeft(c actor.Context) {
 			}
 		}
 	}
-	t.removeSubscribers(subscribersThatLeft, nil)
+	t.removeSubscribers(c, subscribersThatLeft)
 }
 
 // removeSubscribers remove subscribers from the topic
-func (t *TopicActor) removeSubscribers(subscribersThatLeft []subscribeIdentityStruct, logger *slog.Logger) {
+func (t *TopicActor) removeSubscribers(c actor.Context, subscribersThatLeft []subscribeIdentityStruct) {
+	logger := c.Logger()
 	if len(subscribersThatLeft) > 0 {
 		for _, subscriber := range subscribersThatLeft {
+			identity, ok := t.subscribers[subscriber]
 			delete(t.subscribers, subscriber)
+			if ok && t.log != nil {
+				t.forgetSent(subscriber, identity)
+			}
 		}
 		if t.shouldThrottle() == actor.Open {
 			logger.Warn("Topic removed subscribers, because they are dead or they are on members that left the clusterIdentity:", slog.String("topic", t.topic), slog.Any("subscribers", subscribersThatLeft))
 		}
 		t.saveSubscriptionsInTopicActor(logger)
+		t.subscribersChanged(c)
 	}
 }
 
@@ -263,18 +377,65 @@ func (t *TopicActor) saveSubscriptionsInTopicActor(logger *slog.Logger) {
 }
 
 func (t *TopicActor) onUnsubscribe(c actor.Context, msg *UnsubscribeRequest) {
-	delete(t.subscribers, newSubscribeIdentityStruct(msg.Subscriber))
+	identityStruct := newSubscribeIdentityStruct(msg.Subscriber)
+	identity, ok := t.subscribers[identityStruct]
+	delete(t.subscribers, identityStruct)
 	t.saveSubscriptionsInTopicActor(c.Logger())
+	t.subscribersChanged(c)
+	if ok && t.log != nil {
+		t.forgetSent(identityStruct, identity)
+		if identity.Group == "" {
+			delete(t.acked, identityStruct.key())
+			delete(t.ackedAhead, identityStruct.key())
+			t.saveOffsets(c.Logger())
+		}
+	}
 	c.Respond(&UnsubscribeResponse{})
 }
 
 func (t *TopicActor) onSubscribe(c actor.Context, msg *SubscribeRequest) {
-	t.subscribers[newSubscribeIdentityStruct(msg.Subscriber)] = msg.Subscriber
-	c.Logger().Debug("Topic subscribed", slog.String("topic", t.topic), slog.Any("subscriber", msg.Subscriber))
+	identity := msg.Subscriber
+	if msg.Group != "" || msg.Filter != nil {
+		identity = proto.Clone(msg.Subscriber).(*SubscriberIdentity)
+		identity.Group = msg.Group
+		identity.Filter = msg.Filter
+	}
+	identityStruct := newSubscribeIdentityStruct(identity)
+	t.subscribers[identityStruct] = identity
+	c.Logger().Debug("Topic subscribed", slog.String("topic", t.topic), slog.Any("subscriber", identity))
 	t.saveSubscriptionsInTopicActor(c.Logger())
+	t.subscribersChanged(c)
+	if t.log != nil {
+		t.startDelivery(c, identityStruct, identity, msg.Start)
+	}
 	c.Respond(&SubscribeResponse{})
 }
 
+// startDelivery positions the new subscriber, or its consumer group, in the log of the durable topic,
+// and replays the batches it has not acknowledged yet
+func (t *TopicActor) startDelivery(c actor.Context, identityStruct subscribeIdentityStruct, identity *SubscriberIdentity, start *SubscriptionStart) {
+	key := identityStruct.key()
+	if identity.Group != "" {
+		key = groupKey(identity.Group)
+	}
+	to, replay := t.startSubscription(c, key, start)
+	if !replay {
+		return
+	}
+
+	// a target that cannot be located yet gets the batches with the next one
+	var target deliveryTarget
+	var ok bool
+	if identity.Group != "" {
+		target, ok = t.groupTarget(c, identity.Group)
+	} else {
+		target, ok = t.subscriberTarget(c, identityStruct, identity)
+	}
+	if ok {
+		t.replay(c, target, to)
+	}
+}
+
 // pidStruct is a struct that represents a PID
 // It is used to implement the comparison interface
 type pidStruct struct {
@@ -0,0 +1,61 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// pubSubPropagator carries the trace context of the published messages in their headers, to their subscribers
+var pubSubPropagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+func pubSubTracer() trace.Tracer {
+	return otel.Tracer(metrics.LibName)
+}
+
+// injectTraceContext returns the batch with the trace context of ctx in the headers of the envelopes that do not carry
+// a trace context yet. The batch is returned as is when ctx has no trace context.
+func injectTraceContext(ctx context.Context, batch *PubSubBatch) *PubSubBatch {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return batch
+	}
+
+	traced := &PubSubBatch{
+		Envelopes: batch.Envelopes,
+		Headers:   make([]map[string]string, len(batch.Envelopes)),
+	}
+	for i := range batch.Envelopes {
+		header := batch.Header(i)
+		if _, ok := header[traceParentHeader]; !ok {
+			carrier := propagation.MapCarrier{}
+			for key, value := range header {
+				carrier[key] = value
+			}
+			pubSubPropagator.Inject(ctx, carrier)
+			header = carrier
+		}
+		traced.Headers[i] = header
+	}
+
+	return traced
+}
+
+// traceParentHeader is the header of the trace context propagated by pubSubPropagator
+const traceParentHeader = "traceparent"
+
+// PubSubTraceContext returns a context carrying the trace context the message the subscriber is processing was
+// published with. The spans the subscriber starts from it follow the message from its publisher.
+func PubSubTraceContext(c actor.Context) context.Context {
+	carrier := propagation.MapCarrier{}
+	if header := c.MessageHeader(); header != nil {
+		for _, key := range header.Keys() {
+			carrier[key] = header.Get(key)
+		}
+	}
+
+	return pubSubPropagator.Extract(context.Background(), carrier)
+}
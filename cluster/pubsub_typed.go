@@ -0,0 +1,179 @@
+package cluster
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+)
+
+// TypedTopic publishes and subscribes to a topic carrying messages of type T. The publishers and the subscribers
+// of a TypedTopic are checked against the same message type at compile time.
+type TypedTopic[T proto.Message] struct {
+	cluster *Cluster
+	topic   string
+}
+
+// NewTypedTopic creates a TypedTopic for the topic, carrying messages of type T
+func NewTypedTopic[T proto.Message](cluster *Cluster, topic string) *TypedTopic[T] {
+	return &TypedTopic[T]{
+		cluster: cluster,
+		topic:   topic,
+	}
+}
+
+// Topic returns the name of the topic
+func (t *TypedTopic[T]) Topic() string {
+	return t.topic
+}
+
+// Publish publishes the message to the topic
+func (t *TypedTopic[T]) Publish(ctx context.Context, message T, opts ...GrainCallOption) (*PublishResponse, error) {
+	return t.cluster.Publisher().Publish(ctx, t.topic, message, opts...)
+}
+
+// PublishBatch publishes the messages to the topic in one batch
+func (t *TypedTopic[T]) PublishBatch(ctx context.Context, messages []T, opts ...GrainCallOption) (*PublishResponse, error) {
+	batch := &PubSubBatch{Envelopes: make([]proto.Message, 0, len(messages))}
+	for _, message := range messages {
+		batch.Envelopes = append(batch.Envelopes, message)
+	}
+	return t.cluster.Publisher().PublishBatch(ctx, t.topic, batch, opts...)
+}
+
+// BatchingProducer creates a TypedBatchingProducer publishing to the topic
+func (t *TypedTopic[T]) BatchingProducer(opts ...BatchingProducerConfigOption) *TypedBatchingProducer[T] {
+	return &TypedBatchingProducer[T]{producer: t.cluster.BatchingProducer(t.topic, opts...)}
+}
+
+// Subscribe spawns a subscriber calling the handler with each message published to the topic. A batch is acknowledged
+// when the handler returns nil for all its messages. When the handler returns an error, or panics, the rest of the batch
+// is skipped and the delivery fails: it is retried, redelivered to another member of a consumer group, or dead-lettered,
+// as configured. Only messages of type T are delivered to the subscriber.
+func (t *TypedTopic[T]) Subscribe(handler func(T) error, opts ...GrainCallOption) (*TypedSubscription, error) {
+	return t.subscribe("", withoutContext(handler), opts...)
+}
+
+// SubscribeWithContext is Subscribe with a handler getting the context of the span processing the message,
+// continuing the trace the message was published with
+func (t *TypedTopic[T]) SubscribeWithContext(handler func(context.Context, T) error, opts ...GrainCallOption) (*TypedSubscription, error) {
+	return t.subscribe("", handler, opts...)
+}
+
+// SubscribeToGroup is Subscribe in a consumer group, each batch published to the topic goes to one subscriber of the group
+func (t *TypedTopic[T]) SubscribeToGroup(group string, handler func(T) error, opts ...GrainCallOption) (*TypedSubscription, error) {
+	return t.subscribe(group, withoutContext(handler), opts...)
+}
+
+func withoutContext[T proto.Message](handler func(T) error) func(context.Context, T) error {
+	return func(_ context.Context, message T) error {
+		return handler(message)
+	}
+}
+
+func (t *TypedTopic[T]) subscribe(group string, handler func(context.Context, T) error, opts ...GrainCallOption) (*TypedSubscription, error) {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &typedSubscriber[T]{topic: t.topic, handler: handler}
+	})
+	pid := t.cluster.ActorSystem.Root.Spawn(props)
+
+	var zero T
+	_, err := t.cluster.Subscribe(t.topic, &SubscribeRequest{
+		Subscriber: &SubscriberIdentity{Identity: &SubscriberIdentity_Pid{Pid: pid}},
+		Group:      group,
+		Filter:     &SubscriptionFilter{TypeNames: []string{string(proto.MessageName(zero))}},
+	}, opts...)
+	if err != nil {
+		t.cluster.ActorSystem.Root.Stop(pid)
+		return nil, err
+	}
+
+	return &TypedSubscription{cluster: t.cluster, topic: t.topic, pid: pid}, nil
+}
+
+// TypedSubscription is a subscriber spawned by TypedTopic.Subscribe
+type TypedSubscription struct {
+	cluster *Cluster
+	topic   string
+	pid     *actor.PID
+}
+
+// PID returns the PID of the subscriber
+func (s *TypedSubscription) PID() *actor.PID {
+	return s.pid
+}
+
+// Unsubscribe unsubscribes from the topic and stops the subscriber
+func (s *TypedSubscription) Unsubscribe(opts ...GrainCallOption) error {
+	_, err := s.cluster.UnsubscribeByPid(s.topic, s.pid, opts...)
+	s.cluster.ActorSystem.Root.Stop(s.pid)
+	return err
+}
+
+// typedSubscriber calls the handler with the messages of the batches it receives, and fails the batches the handler fails
+type typedSubscriber[T proto.Message] struct {
+	topic   string
+	handler func(context.Context, T) error
+	err     error // first error of the handler in the current batch
+}
+
+var _ batchResponder = (*typedSubscriber[proto.Message])(nil)
+
+func (s *typedSubscriber[T]) Receive(c actor.Context) {
+	message, ok := c.Message().(T)
+	if !ok || s.err != nil {
+		// the rest of a failed batch is delivered again with it
+		return
+	}
+
+	ctx, span := pubSubTracer().Start(PubSubTraceContext(c), s.topic+" process", trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attribute.String("messaging.destination.name", s.topic)))
+	defer span.End()
+
+	if s.err = s.handle(ctx, message); s.err != nil {
+		span.RecordError(s.err)
+		span.SetStatus(codes.Error, s.err.Error())
+		c.Logger().Warn("Typed subscriber failed to process message", slog.String("type", string(proto.MessageName(message))), slog.Any("error", s.err))
+	}
+}
+
+// handle calls the handler, turning a panic into an error so that the batch is not acknowledged
+func (s *typedSubscriber[T]) handle(ctx context.Context, message T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("subscriber panicked: %v", r)
+		}
+	}()
+
+	return s.handler(ctx, message)
+}
+
+func (s *typedSubscriber[T]) batchResponse() *PublishResponse {
+	failed := s.err != nil
+	s.err = nil
+	if failed {
+		return &PublishResponse{Status: PublishStatus_Failed}
+	}
+
+	return &PublishResponse{Status: PublishStatus_Ok}
+}
+
+// TypedBatchingProducer is a BatchingProducer of messages of type T
+type TypedBatchingProducer[T proto.Message] struct {
+	producer *BatchingProducer
+}
+
+// Produce adds the message to the next batch published to the topic
+func (p *TypedBatchingProducer[T]) Produce(ctx context.Context, message T) (*ProduceProcessInfo, error) {
+	return p.producer.Produce(ctx, message)
+}
+
+// Dispose stops the producer, the messages it has not published yet are cancelled
+func (p *TypedBatchingProducer[T]) Dispose() {
+	p.producer.Dispose()
+}
@@ -0,0 +1,219 @@
+package cluster
+
+import (
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"google.golang.org/protobuf/proto"
+)
+
+// PubSubPatternsKey is the gossip key of the wildcard topic patterns with subscribers on a member
+const PubSubPatternsKey = "pubsub-patterns"
+
+const (
+	topicSeparator      = "."
+	topicSingleWildcard = "*" // matches one segment of a topic name
+	topicTailWildcard   = ">" // matches the remaining segments of a topic name, at least one
+)
+
+// IsTopicPattern reports whether the topic name has wildcard segments. Subscribing to a pattern,
+// such as orders.* or orders.>, subscribes to all the topics matching it.
+func IsTopicPattern(topic string) bool {
+	for _, segment := range strings.Split(topic, topicSeparator) {
+		if segment == topicSingleWildcard || segment == topicTailWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchTopic reports whether the hierarchical topic name, with segments separated by dots, matches the pattern.
+// A * segment matches any one segment, a trailing > segment matches one or more segments.
+func MatchTopic(pattern string, topic string) bool {
+	patternSegments := strings.Split(pattern, topicSeparator)
+	topicSegments := strings.Split(topic, topicSeparator)
+
+	for i, segment := range patternSegments {
+		if segment == topicTailWildcard && i == len(patternSegments)-1 {
+			return len(topicSegments) > i
+		}
+		if i >= len(topicSegments) {
+			return false
+		}
+		if segment != topicSingleWildcard && segment != topicSegments[i] {
+			return false
+		}
+	}
+
+	return len(patternSegments) == len(topicSegments)
+}
+
+// topicPatterns keeps the wildcard topic patterns with subscribers, on this member and gossiped by the others
+type topicPatterns struct {
+	mutex  sync.RWMutex
+	local  map[string]struct{}
+	remote map[string][]string // patterns by member id
+}
+
+func newTopicPatterns() *topicPatterns {
+	return &topicPatterns{
+		local:  map[string]struct{}{},
+		remote: map[string][]string{},
+	}
+}
+
+// matching returns the patterns the topic matches
+func (tp *topicPatterns) matching(topic string) []string {
+	tp.mutex.RLock()
+	defer tp.mutex.RUnlock()
+
+	var patterns []string
+	add := func(pattern string) {
+		if MatchTopic(pattern, topic) && !slices.Contains(patterns, pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	for pattern := range tp.local {
+		add(pattern)
+	}
+	for _, memberPatterns := range tp.remote {
+		for _, pattern := range memberPatterns {
+			add(pattern)
+		}
+	}
+
+	return patterns
+}
+
+// setLocal adds or removes the pattern of this member, it returns the patterns of this member when they changed
+func (tp *topicPatterns) setLocal(pattern string, subscribed bool) ([]string, bool) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+
+	if _, ok := tp.local[pattern]; ok == subscribed {
+		return nil, false
+	}
+	if subscribed {
+		tp.local[pattern] = struct{}{}
+	} else {
+		delete(tp.local, pattern)
+	}
+
+	patterns := make([]string, 0, len(tp.local))
+	for p := range tp.local {
+		patterns = append(patterns, p)
+	}
+	slices.Sort(patterns)
+
+	return patterns, true
+}
+
+func (tp *topicPatterns) setRemote(memberID string, patterns []string) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+
+	if len(patterns) == 0 {
+		delete(tp.remote, memberID)
+		return
+	}
+	tp.remote[memberID] = patterns
+}
+
+// subscribeToTopicPatterns keeps the patterns gossiped by the other members
+func (p *PubSub) subscribeToTopicPatterns() {
+	c := p.cluster
+	c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		switch e := evt.(type) {
+		case *GossipUpdate:
+			if e.Key != PubSubPatternsKey || e.MemberID == c.ActorSystem.ID {
+				return
+			}
+
+			var patterns PubSubPatterns
+			if err := e.Value.UnmarshalTo(&patterns); err != nil {
+				c.Logger().Warn("could not unpack into PubSubPatterns proto.Message form Any", slog.Any("error", err))
+				return
+			}
+			p.patterns.setRemote(e.MemberID, patterns.Patterns)
+		case *ClusterTopology:
+			for _, member := range e.Left {
+				p.patterns.setRemote(member.Id, nil)
+			}
+		}
+	})
+}
+
+// setPatternSubscribed registers the pattern of a wildcard topic while it has subscribers, and gossips the change
+func (p *PubSub) setPatternSubscribed(pattern string, subscribed bool) {
+	if patterns, changed := p.patterns.setLocal(pattern, subscribed); changed {
+		p.cluster.Gossip.SetState(PubSubPatternsKey, &PubSubPatterns{Patterns: patterns})
+	}
+}
+
+// updatePatternRegistration registers the wildcard topic as long as it has subscribers
+func (t *TopicActor) updatePatternRegistration(c actor.Context, subscribed bool) {
+	if IsTopicPattern(t.topic) {
+		GetPubSub(c.ActorSystem()).setPatternSubscribed(t.topic, subscribed)
+	}
+}
+
+// forwardToPatterns forwards the batch to the wildcard topics matching the topic, which deliver it to their subscribers
+func (t *TopicActor) forwardToPatterns(c actor.Context, batch *PubSubBatch) {
+	if IsTopicPattern(t.topic) {
+		return
+	}
+
+	cluster := GetCluster(c.ActorSystem())
+	for _, pattern := range GetPubSub(c.ActorSystem()).patterns.matching(t.topic) {
+		// dead-letter topics only match the patterns of dead-letter topics, such as $deadletter.>
+		if IsDeadLetterTopic(pattern) != IsDeadLetterTopic(t.topic) {
+			continue
+		}
+		if pid := cluster.Get(pattern, TopicActorKind); pid != nil {
+			// the response of the wildcard topic is ignored
+			c.Request(pid, batch)
+		}
+	}
+}
+
+// Matches reports whether the message, with its header, passes the filter. Every message passes a nil filter.
+func (f *SubscriptionFilter) Matches(message proto.Message, header map[string]string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.TypeNames) > 0 && !slices.Contains(f.TypeNames, string(proto.MessageName(message))) {
+		return false
+	}
+	for key, value := range f.Headers {
+		if header[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterBatch returns the messages of the batch that pass the filter, with their headers
+func filterBatch(batch *PubSubBatch, filter *SubscriptionFilter) *PubSubBatch {
+	if filter == nil {
+		return batch
+	}
+
+	filtered := &PubSubBatch{Envelopes: make([]proto.Message, 0, len(batch.Envelopes))}
+	for i, envelope := range batch.Envelopes {
+		if header := batch.Header(i); filter.Matches(envelope, header) {
+			filtered.Envelopes = append(filtered.Envelopes, envelope)
+			if header != nil {
+				if filtered.Headers == nil {
+					filtered.Headers = make([]map[string]string, len(batch.Envelopes))
+				}
+				filtered.Headers[len(filtered.Envelopes)-1] = header
+			}
+		}
+	}
+
+	return filtered
+}
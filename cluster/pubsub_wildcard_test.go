@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		pattern string
+		topic   string
+		match   bool
+	}{
+		{"orders.eu", "orders.eu", true},
+		{"orders.eu", "orders.us", false},
+		{"orders.*", "orders.eu", true},
+		{"orders.*", "orders", false},
+		{"orders.*", "orders.eu.paris", false},
+		{"orders.*.paris", "orders.eu.paris", true},
+		{"*.eu", "orders.eu", true},
+		{"orders.>", "orders.eu", true},
+		{"orders.>", "orders.eu.paris", true},
+		{"orders.>", "orders", false},
+		{">", "orders", true},
+		{"orders.>.paris", "orders.eu.paris", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, MatchTopic(tt.pattern, tt.topic), "%s %s", tt.pattern, tt.topic)
+	}
+
+	assert.True(t, IsTopicPattern("orders.*"))
+	assert.True(t, IsTopicPattern("orders.>"))
+	assert.False(t, IsTopicPattern("orders.eu"))
+}
+
+func TestSubscriptionFilter_Matches(t *testing.T) {
+	batch := &PubSubBatch{
+		Envelopes: []proto.Message{wrapperspb.String("a"), wrapperspb.Int32(1), wrapperspb.String("b")},
+		Headers:   []map[string]string{{"region": "eu"}, {"region": "eu"}},
+	}
+
+	filtered := filterBatch(batch, &SubscriptionFilter{TypeNames: []string{"google.protobuf.StringValue"}})
+	assert.Equal(t, []proto.Message{batch.Envelopes[0], batch.Envelopes[2]}, filtered.Envelopes)
+
+	filtered = filterBatch(batch, &SubscriptionFilter{Headers: map[string]string{"region": "eu"}})
+	assert.Equal(t, []proto.Message{batch.Envelopes[0], batch.Envelopes[1]}, filtered.Envelopes)
+
+	filtered = filterBatch(batch, nil)
+	assert.Len(t, filtered.Envelopes, 3)
+}
+
+func TestPubSubBatch_SerializesHeaders(t *testing.T) {
+	batch := &PubSubBatch{
+		Envelopes: []proto.Message{wrapperspb.String("a"), wrapperspb.String("b")},
+		Headers:   []map[string]string{nil, {"region": "eu"}},
+	}
+
+	serializers := remote.DefaultSerializers()
+	transport, err := batch.Serialize(serializers)
+	assert.NoError(t, err)
+	deserialized, err := transport.(*PubSubBatchTransport).Deserialize(serializers)
+	assert.NoError(t, err)
+
+	assert.Nil(t, deserialized.(*PubSubBatch).Header(0))
+	assert.Equal(t, map[string]string{"region": "eu"}, deserialized.(*PubSubBatch).Header(1))
+}
+
+// countingSerializer counts the messages it serializes
+type countingSerializer struct {
+	remote.Serializer
+	serialized int
+}
+
+func (s *countingSerializer) Serialize(msg interface{}) ([]byte, error) {
+	s.serialized++
+	return s.Serializer.Serialize(msg)
+}
+
+func TestPubSubBatch_SerializesWithTheGivenSerializers(t *testing.T) {
+	const serializerID = 100
+	serializers := remote.NewSerializerRegistry()
+	protoSerializer, _ := serializers.Get(remote.DefaultSerializerID)
+	counting := &countingSerializer{Serializer: protoSerializer}
+	serializers.Register(serializerID, counting)
+	assert.NoError(t, serializers.RegisterType(&wrapperspb.StringValue{}, serializerID))
+	batch := &PubSubBatch{Envelopes: []proto.Message{wrapperspb.String("a")}}
+
+	transport, err := batch.Serialize(serializers)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, counting.serialized)
+	assert.Equal(t, int32(serializerID), transport.(*PubSubBatchTransport).Envelopes[0].SerializerId)
+
+	deserialized, err := transport.(*PubSubBatchTransport).Deserialize(serializers)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", deserialized.(*PubSubBatch).Envelopes[0].(*wrapperspb.StringValue).Value)
+}
@@ -20,6 +20,7 @@ type Rendezvous struct {
 	hasher     hash.Hash32
 	hasherLock sync.Mutex
 	members    []*memberData
+	placement  func(kind string) *Placement
 }
 
 func NewRendezvous() *Rendezvous {
@@ -29,12 +30,22 @@ func NewRendezvous() *Rendezvous {
 	}
 }
 
+// WithPlacement makes the rendezvous pick the owner of an identity among the candidates of the placement of its kind
+func (r *Rendezvous) WithPlacement(placement func(kind string) *Placement) *Rendezvous {
+	r.placement = placement
+
+	return r
+}
+
 func (r *Rendezvous) GetByClusterIdentity(ci *ClusterIdentity) string {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	identity := ci.Identity
 	m := r.memberDataByKind(ci.Kind)
+	if r.placement != nil {
+		m = r.placedMemberData(m, r.placement(ci.Kind), []byte(identity))
+	}
 
 	l := len(m)
 
@@ -85,6 +96,44 @@ func (r *Rendezvous) memberDataByKind(kind string) []*memberData {
 	return m
 }
 
+// placedMemberData narrows the members to the candidates of the placement, picking a group of the spread label
+// by its hash first so that identities spread evenly across the label values
+func (r *Rendezvous) placedMemberData(m []*memberData, placement *Placement, keyBytes []byte) []*memberData {
+	if placement == nil {
+		return m
+	}
+
+	members := make(Members, len(m))
+	for i, md := range m {
+		members[i] = md.member
+	}
+
+	groups := placement.spread(placement.Candidates(members))
+	group := groups[0]
+	if len(groups) > 1 {
+		var maxScore uint32
+		for _, g := range groups {
+			score := r.hash([]byte(g[0].Labels[placement.SpreadLabel]), keyBytes)
+			if score > maxScore {
+				maxScore = score
+				group = g
+			}
+		}
+	}
+
+	placed := make([]*memberData, 0, len(group))
+	for _, md := range m {
+		for _, member := range group {
+			if md.member == member {
+				placed = append(placed, md)
+				break
+			}
+		}
+	}
+
+	return placed
+}
+
 func (r *Rendezvous) UpdateMembers(members Members) {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+)
+
+// errNotSent is returned by an attempt of retryRequest which could not send the request yet,
+// e.g. as the pid of the grain is not known, the attempt is retried
+var errNotSent = errors.New("request not sent")
+
+// IsTransient reports whether a failed grain request may succeed when retried: the request or its response
+// got lost or timed out, or the grain answered that it is unavailable or exhausted for now.
+// Other errors are returned to the caller right away.
+func IsTransient(err error) bool {
+	switch {
+	case errors.Is(err, actor.ErrTimeout), errors.Is(err, remote.ErrTimeout),
+		errors.Is(err, actor.ErrDeadLetter), errors.Is(err, remote.ErrDeadLetter):
+		return true
+	}
+
+	var grainErr *GrainErrorResponse
+	if errors.As(err, &grainErr) {
+		switch grainErr.Reason {
+		case ErrorReason_UNAVAILABLE, ErrorReason_RESOURCE_EXHAUSTED:
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithRetryPolicy retries the errors for which shouldRetry returns true, instead of the transient ones
+func WithRetryPolicy(shouldRetry func(err error) bool) GrainCallOption {
+	return func(config *GrainCallConfig) {
+		config.ShouldRetry = shouldRetry
+	}
+}
+
+// retryRequest makes attempts until one returns a response or an error which is not retried, the retry count
+// of the call config is reached or the deadline passed. The errors for which ShouldRetry returns true are retried,
+// and so are the grain error responses, except on the last attempt which returns the error response.
+// onRetry, if any, is called with the error of every attempt which is retried.
+func retryRequest(callConfig *GrainCallConfig, deadline time.Time, attempt func() (interface{}, error), onRetry func(err error)) (interface{}, error) {
+	var err error
+	for counter := 0; counter < callConfig.RetryCount; {
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("request failed: %w", context.DeadlineExceeded)
+		}
+
+		var resp interface{}
+		resp, err = attempt()
+		if grainErr, ok := resp.(*GrainErrorResponse); ok && counter+1 < callConfig.RetryCount && callConfig.ShouldRetry(grainErr) {
+			// the grain cannot serve the request for now, the last attempt returns its error response
+			counter = callConfig.RetryAction(counter)
+			continue
+		}
+		if resp != nil {
+			return resp, nil
+		}
+		if !errors.Is(err, errNotSent) && !callConfig.ShouldRetry(err) {
+			return nil, err
+		}
+
+		if onRetry != nil {
+			onRetry(err)
+		}
+		counter = callConfig.RetryAction(counter)
+	}
+
+	return nil, fmt.Errorf("have reached max retries: %v: %w", callConfig.RetryCount, err)
+}
@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransient(t *testing.T) {
+	assert.True(t, IsTransient(actor.ErrTimeout))
+	assert.True(t, IsTransient(remote.ErrDeadLetter))
+	assert.True(t, IsTransient(fmt.Errorf("wrapped: %w", actor.ErrDeadLetter)))
+	assert.True(t, IsTransient(NewGrainErrorResponse(ErrorReason_UNAVAILABLE, "")))
+
+	assert.False(t, IsTransient(NewGrainErrorResponse(ErrorReason_INVALID_ARGUMENT, "")))
+	assert.False(t, IsTransient(errors.New("boom")))
+}
+
+func TestRetryRequest(t *testing.T) {
+	callConfig := &GrainCallConfig{RetryCount: 3, ShouldRetry: IsTransient, RetryAction: func(i int) int { return i + 1 }}
+	deadline := time.Now().Add(time.Minute)
+
+	var retried []error
+	errs := []error{errNotSent, actor.ErrTimeout}
+	res, err := retryRequest(callConfig, deadline, func() (interface{}, error) {
+		if len(errs) > 0 {
+			err := errs[0]
+			errs = errs[1:]
+			return nil, err
+		}
+		return "done", nil
+	}, func(err error) { retried = append(retried, err) })
+	assert.NoError(t, err)
+	assert.Equal(t, "done", res)
+	assert.Equal(t, []error{errNotSent, actor.ErrTimeout}, retried)
+
+	attempts := 0
+	_, err = retryRequest(callConfig, deadline, func() (interface{}, error) {
+		attempts++
+		return nil, errors.New("boom")
+	}, nil)
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, 1, attempts)
+
+	// the last attempt returns the grain error response
+	attempts = 0
+	res, err = retryRequest(callConfig, deadline, func() (interface{}, error) {
+		attempts++
+		return NewGrainErrorResponse(ErrorReason_UNAVAILABLE, ""), nil
+	}, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &GrainErrorResponse{}, res)
+	assert.Equal(t, 3, attempts)
+
+	_, err = retryRequest(callConfig, deadline, func() (interface{}, error) { return nil, actor.ErrDeadLetter }, nil)
+	assert.ErrorIs(t, err, actor.ErrDeadLetter)
+	assert.ErrorContains(t, err, "max retries")
+
+	_, err = retryRequest(callConfig, time.Now(), func() (interface{}, error) { return "done", nil }, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,372 @@
+package cluster
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/eventstream"
+)
+
+const (
+	singletonActorName = "singleton"
+
+	singletonLocateInterval  = 100 * time.Millisecond
+	singletonProxyBufferSize = 10000
+)
+
+// singletonRefresh makes the singleton manager check which singletons this member hosts
+type singletonRefresh struct{}
+
+// singletonLocateTick makes a singleton proxy try to locate its singleton again
+type singletonLocateTick struct{}
+
+// startSingletons spawns the actor running the singletons hosted by this member
+func (c *Cluster) startSingletons() {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &singletonManager{
+			cluster:    c,
+			address:    c.ActorSystem.Address(),
+			singletons: map[string]*actor.PID{},
+		}
+	})
+	if _, err := c.ActorSystem.Root.SpawnNamed(props, singletonActorName); err != nil {
+		panic(err) // let it crash
+	}
+}
+
+// singletonKinds returns the singleton kinds this member hosts, leaving out the kinds whose placement requires
+// labels or roles this member does not have
+func (c *Cluster) singletonKinds() []string {
+	self := &Member{Labels: c.Config.Labels, Roles: c.Config.Roles}
+	var kinds []string
+	for k, kind := range c.kinds {
+		if kind.Singleton && kind.Placement.Allows(self) {
+			kinds = append(kinds, k)
+		}
+	}
+
+	return kinds
+}
+
+// advertiseSingletons gossips the singleton kinds this member hosts once it is up, with its join order.
+// The join order does not depend on the clocks of the members, members going up at the same time get the same
+// join order and their ids break the tie.
+func (c *Cluster) advertiseSingletons() {
+	kinds := c.singletonKinds()
+	if len(kinds) == 0 {
+		return
+	}
+
+	hosts, err := c.Gossip.GetState(SingletonKey)
+	if err != nil {
+		c.Logger().Error("Could not get singleton hosts, singletons are not hosted by this member", slog.Any("error", err))
+		return
+	}
+
+	joinOrder := uint64(1)
+	for _, value := range hosts {
+		var host SingletonHost
+		if value.Value != nil && value.Value.UnmarshalTo(&host) == nil && host.JoinOrder >= joinOrder {
+			joinOrder = host.JoinOrder + 1
+		}
+	}
+
+	c.Gossip.SetState(SingletonKey, &SingletonHost{Kinds: kinds, JoinOrder: joinOrder})
+	// the gossip of this member's own state is not published, the manager has to check itself
+	c.ActorSystem.Root.Send(c.ActorSystem.NewLocalPID(singletonActorName), &singletonRefresh{})
+}
+
+// stopSingletons stops the singletons of this member, so that the next member hosting them takes them over
+func (c *Cluster) stopSingletons() {
+	// clients do not run singletons
+	if _, ok := c.ActorSystem.ProcessRegistry.GetLocal(singletonActorName); !ok {
+		return
+	}
+
+	_ = c.ActorSystem.Root.PoisonFuture(c.ActorSystem.NewLocalPID(singletonActorName)).Wait()
+}
+
+// singletonHost returns the address of the member running the singleton of the kind, the member hosting the kind
+// with the lowest join order, or "" as long as no member hosting the kind is up
+func (c *Cluster) singletonHost(kind string) string {
+	hosts, err := c.Gossip.GetState(SingletonKey)
+	if err != nil {
+		return ""
+	}
+
+	var host *Member
+	var hostJoinOrder uint64
+	// members are sorted by id, which breaks ties
+	for _, member := range c.MemberList.Members().Members() {
+		value, ok := hosts[member.Id]
+		if !ok || value.Value == nil {
+			continue
+		}
+		var singletonHost SingletonHost
+		if err := value.Value.UnmarshalTo(&singletonHost); err != nil || !slices.Contains(singletonHost.Kinds, kind) {
+			continue
+		}
+		// a leaving member hands its singletons over to the next member
+		if c.MemberList.State(member.Id) != MemberState_Up {
+			continue
+		}
+
+		if host == nil || singletonHost.JoinOrder < hostJoinOrder {
+			host, hostJoinOrder = member, singletonHost.JoinOrder
+		}
+	}
+
+	if host == nil {
+		return ""
+	}
+
+	return host.Address()
+}
+
+// singletonManager runs the singleton kinds for which this member is the host
+type singletonManager struct {
+	cluster      *Cluster
+	address      string
+	singletons   map[string]*actor.PID
+	subscription *eventstream.Subscription
+}
+
+func (m *singletonManager) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		m.onStarted(ctx)
+	case *actor.Stopping:
+		if m.subscription != nil {
+			ctx.ActorSystem().EventStream.Unsubscribe(m.subscription)
+			m.subscription = nil
+		}
+	case *singletonRefresh:
+		m.refresh(ctx)
+	case *actor.Terminated:
+		m.onTerminated(msg, ctx)
+	case *SingletonLocate:
+		ctx.Respond(&SingletonLocated{Pid: m.singletons[msg.Kind]})
+	}
+}
+
+func (m *singletonManager) onStarted(ctx actor.Context) {
+	self := ctx.Self()
+	system := ctx.ActorSystem()
+	m.subscription = system.EventStream.Subscribe(func(evt interface{}) {
+		switch e := evt.(type) {
+		case *ClusterTopology:
+			system.Root.Send(self, &singletonRefresh{})
+		case *GossipUpdate:
+			if e.Key == SingletonKey {
+				system.Root.Send(self, &singletonRefresh{})
+			}
+		}
+	})
+
+	ctx.Send(self, &singletonRefresh{})
+}
+
+func (m *singletonManager) refresh(ctx actor.Context) {
+	for _, kind := range m.cluster.kinds {
+		if !kind.Singleton {
+			continue
+		}
+
+		host := m.cluster.singletonHost(kind.Kind)
+		pid, running := m.singletons[kind.Kind]
+		switch {
+		case host == m.address && !running:
+			// the identity of a singleton is its kind
+			props := WithClusterIdentity(kind.Props, NewClusterIdentity(kind.Kind, kind.Kind))
+			pid, err := ctx.SpawnNamed(props, kind.Kind)
+			if err != nil {
+				ctx.Logger().Error("Failed to start singleton", slog.String("kind", kind.Kind), slog.Any("error", err))
+				continue
+			}
+			m.singletons[kind.Kind] = pid
+			ctx.Logger().Info("Started singleton", slog.String("kind", kind.Kind))
+		case host != "" && host != m.address && running:
+			// another member hosts the kind, stop ours before proxies locate the other one
+			delete(m.singletons, kind.Kind)
+			ctx.Poison(pid)
+			ctx.Logger().Info("Stopped singleton hosted by another member", slog.String("kind", kind.Kind), slog.String("host", host))
+		}
+	}
+}
+
+func (m *singletonManager) onTerminated(msg *actor.Terminated, ctx actor.Context) {
+	for kind, pid := range m.singletons {
+		if pid.Equal(msg.Who) {
+			// this member still hosts the kind, start it again
+			delete(m.singletons, kind)
+			ctx.Send(ctx.Self(), &singletonRefresh{})
+			return
+		}
+	}
+}
+
+// SingletonProxy sends messages to the singleton of a kind wherever it runs.
+// Messages are buffered while the singleton is located, started or moved to another member.
+type SingletonProxy struct {
+	cluster *Cluster
+	pid     *actor.PID
+}
+
+// NewSingletonProxy spawns a proxy to the singleton of the kind
+func NewSingletonProxy(cluster *Cluster, kind string) *SingletonProxy {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &singletonProxyActor{cluster: cluster, kind: kind}
+	})
+
+	return &SingletonProxy{
+		cluster: cluster,
+		pid:     cluster.ActorSystem.Root.SpawnPrefix(props, "singleton-proxy-"+kind),
+	}
+}
+
+// PID returns the proxy actor, messages sent to it are forwarded to the singleton with their sender
+func (p *SingletonProxy) PID() *actor.PID {
+	return p.pid
+}
+
+// Send sends a message to the singleton
+func (p *SingletonProxy) Send(message interface{}) {
+	p.cluster.ActorSystem.Root.Send(p.pid, message)
+}
+
+// Request sends a message to the singleton and waits for its response
+func (p *SingletonProxy) Request(message interface{}, timeout time.Duration) (interface{}, error) {
+	return p.RequestFuture(message, timeout).Result()
+}
+
+// RequestFuture sends a message to the singleton and returns the future of its response
+func (p *SingletonProxy) RequestFuture(message interface{}, timeout time.Duration) *actor.Future {
+	return p.cluster.ActorSystem.Root.RequestFuture(p.pid, message, timeout)
+}
+
+// Stop stops the proxy, buffered messages are dropped
+func (p *SingletonProxy) Stop() {
+	p.cluster.ActorSystem.Root.Stop(p.pid)
+}
+
+type singletonProxyActor struct {
+	cluster      *Cluster
+	kind         string
+	singleton    *actor.PID
+	locating     bool
+	buffer       []*actor.MessageEnvelope
+	subscription *eventstream.Subscription
+}
+
+func (p *singletonProxyActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		p.onStarted(ctx)
+	case *actor.Stopping:
+		if p.subscription != nil {
+			ctx.ActorSystem().EventStream.Unsubscribe(p.subscription)
+			p.subscription = nil
+		}
+	case *actor.Stopped, *actor.Restarting:
+	case *ClusterTopology:
+		p.onClusterTopology(msg, ctx)
+	case *actor.Terminated:
+		if p.singleton != nil && msg.Who.Equal(p.singleton) {
+			p.lost(ctx)
+		}
+	case *singletonLocateTick:
+		p.locating = false
+		p.locate(ctx)
+	default:
+		if p.singleton != nil {
+			ctx.Forward(p.singleton)
+			return
+		}
+
+		if len(p.buffer) >= singletonProxyBufferSize {
+			ctx.Logger().Warn("Singleton proxy buffer is full, dropping message", slog.String("kind", p.kind))
+			return
+		}
+		p.buffer = append(p.buffer, &actor.MessageEnvelope{Header: ctx.MessageHeader().ToMap(), Message: msg, Sender: ctx.Sender()})
+		p.locate(ctx)
+	}
+}
+
+func (p *singletonProxyActor) onStarted(ctx actor.Context) {
+	self := ctx.Self()
+	system := ctx.ActorSystem()
+	p.subscription = system.EventStream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(*ClusterTopology); ok {
+			system.Root.Send(self, topology)
+		}
+	})
+
+	p.locate(ctx)
+}
+
+// onClusterTopology drops the singleton when its member leaves or another member hosts it
+func (p *singletonProxyActor) onClusterTopology(msg *ClusterTopology, ctx actor.Context) {
+	if p.singleton == nil {
+		return
+	}
+
+	for _, member := range msg.Left {
+		if member.Address() == p.singleton.Address {
+			p.lost(ctx)
+			return
+		}
+	}
+
+	if host := p.cluster.singletonHost(p.kind); host != "" && host != p.singleton.Address {
+		p.lost(ctx)
+	}
+}
+
+func (p *singletonProxyActor) lost(ctx actor.Context) {
+	ctx.Unwatch(p.singleton)
+	p.singleton = nil
+	p.locate(ctx)
+}
+
+func (p *singletonProxyActor) locate(ctx actor.Context) {
+	if p.locating || p.singleton != nil {
+		return
+	}
+	p.locating = true
+
+	host := p.cluster.singletonHost(p.kind)
+	if host == "" {
+		p.retry(ctx)
+		return
+	}
+
+	future := ctx.RequestFuture(actor.NewPID(host, singletonActorName), &SingletonLocate{Kind: p.kind}, p.cluster.Config.TimeoutTime)
+	ctx.ReenterAfter(future, func(res interface{}, err error) {
+		located, ok := res.(*SingletonLocated)
+		if err != nil || !ok || located.Pid == nil {
+			// the host has not started the singleton yet
+			p.retry(ctx)
+			return
+		}
+
+		p.locating = false
+		p.singleton = located.Pid
+		ctx.Watch(p.singleton)
+
+		buffer := p.buffer
+		p.buffer = nil
+		for _, envelope := range buffer {
+			ctx.Send(p.singleton, envelope)
+		}
+	})
+}
+
+func (p *singletonProxyActor) retry(ctx actor.Context) {
+	self := ctx.Self()
+	system := ctx.ActorSystem()
+	time.AfterFunc(singletonLocateInterval, func() {
+		system.Root.Send(self, &singletonLocateTick{})
+	})
+}
@@ -0,0 +1,304 @@
+package cluster
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/eventstream"
+)
+
+// defaultSplitBrainStableAfter is the time the topology must not change before the split brain resolver decides
+const defaultSplitBrainStableAfter = 20 * time.Second
+
+// SplitBrainPartition is the view one side of a network partition has of the cluster
+type SplitBrainPartition struct {
+	Self        *Member
+	Reachable   Members          // the members this member still sees, itself included
+	Unreachable Members          // the members this member lost without them leaving gracefully
+	StartedAt   map[string]int64 // the gossiped start times of the members, in unix milliseconds, by member id
+}
+
+// SplitBrainStrategy decides which side of a network partition keeps running. Each side decides on its own,
+// a strategy must make the sides reach opposite decisions from their views of the cluster.
+type SplitBrainStrategy interface {
+	// Survives reports whether the reachable members keep running without the unreachable ones
+	Survives(partition *SplitBrainPartition) bool
+}
+
+// SplitBrainResolved is published on the event stream when the split brain resolver decided which side survives.
+// The members of the losing side stop right after.
+type SplitBrainResolved struct {
+	Survived    bool
+	Reachable   Members
+	Unreachable Members
+}
+
+type keepMajority struct{}
+
+// KeepMajority keeps the side with more than half of the members. On a tie, the side with the member
+// of the lowest address survives.
+func KeepMajority() SplitBrainStrategy {
+	return keepMajority{}
+}
+
+func (keepMajority) Survives(partition *SplitBrainPartition) bool {
+	reachable, unreachable := len(partition.Reachable), len(partition.Unreachable)
+	if reachable != unreachable {
+		return reachable > unreachable
+	}
+
+	return lowestAddress(partition.Reachable) < lowestAddress(partition.Unreachable)
+}
+
+func lowestAddress(members Members) string {
+	lowest := ""
+	for _, member := range members {
+		if address := member.Address(); lowest == "" || address < lowest {
+			lowest = address
+		}
+	}
+
+	return lowest
+}
+
+type keepOldest struct {
+	downIfAlone bool
+}
+
+// KeepOldest keeps the side with the oldest member, the one which started first. With downIfAlone, the other side
+// survives when the oldest member is left alone while the other side has several members.
+func KeepOldest(downIfAlone bool) SplitBrainStrategy {
+	return keepOldest{downIfAlone: downIfAlone}
+}
+
+func (s keepOldest) Survives(partition *SplitBrainPartition) bool {
+	all := append(slices.Clone(partition.Reachable), partition.Unreachable...)
+	oldest := oldestMember(all, partition.StartedAt)
+	if oldest == nil {
+		return false
+	}
+
+	hasOldest := slices.ContainsFunc(partition.Reachable, func(m *Member) bool { return m.Id == oldest.Id })
+	if s.downIfAlone {
+		if hasOldest && len(partition.Reachable) == 1 && len(partition.Unreachable) > 1 {
+			return false
+		}
+		if !hasOldest && len(partition.Unreachable) == 1 && len(partition.Reachable) > 1 {
+			return true
+		}
+	}
+
+	return hasOldest
+}
+
+// oldestMember returns the member which started first, members whose start is not gossiped are the youngest
+// and the member ids break ties
+func oldestMember(members Members, startedAt map[string]int64) *Member {
+	var oldest *Member
+	older := func(m *Member) bool {
+		started, ok := startedAt[m.Id]
+		oldestStarted, oldestOk := startedAt[oldest.Id]
+		switch {
+		case ok != oldestOk:
+			return ok
+		case started != oldestStarted:
+			return started < oldestStarted
+		default:
+			return m.Id < oldest.Id
+		}
+	}
+
+	for _, member := range members {
+		if oldest == nil || older(member) {
+			oldest = member
+		}
+	}
+
+	return oldest
+}
+
+type staticQuorum struct {
+	size int
+}
+
+// StaticQuorum keeps the side with at least size members. The size must be more than half of the members
+// the cluster is planned for, or both sides of a partition may survive.
+func StaticQuorum(size int) SplitBrainStrategy {
+	return staticQuorum{size: size}
+}
+
+func (s staticQuorum) Survives(partition *SplitBrainPartition) bool {
+	return len(partition.Reachable) >= s.size
+}
+
+type keepReferee struct {
+	address        string
+	minimumMembers int
+}
+
+// KeepReferee keeps the side with the member of the referee address, as long as the side has at least
+// minimumMembers members.
+func KeepReferee(address string, minimumMembers int) SplitBrainStrategy {
+	return keepReferee{address: address, minimumMembers: minimumMembers}
+}
+
+func (s keepReferee) Survives(partition *SplitBrainPartition) bool {
+	if len(partition.Reachable) < s.minimumMembers {
+		return false
+	}
+
+	return slices.ContainsFunc(partition.Reachable, func(m *Member) bool { return m.Address() == s.address })
+}
+
+// splitBrainResolver keeps the members this member lost, and once the topology is stable long enough,
+// decides with the strategy whether this member keeps running
+type splitBrainResolver struct {
+	cluster      *Cluster
+	strategy     SplitBrainStrategy
+	stableAfter  time.Duration
+	subscription *eventstream.Subscription
+
+	mutex       sync.Mutex
+	reachable   Members
+	unreachable map[string]*Member
+	timer       *time.Timer
+	stopped     bool
+}
+
+// startSplitBrainResolver starts the split brain resolver when a strategy is configured
+func (c *Cluster) startSplitBrainResolver() {
+	if c.Config.SplitBrainStrategy == nil {
+		return
+	}
+
+	stableAfter := c.Config.SplitBrainStableAfter
+	if stableAfter <= 0 {
+		stableAfter = defaultSplitBrainStableAfter
+	}
+	r := &splitBrainResolver{
+		cluster:     c,
+		strategy:    c.Config.SplitBrainStrategy,
+		stableAfter: stableAfter,
+		unreachable: map[string]*Member{},
+	}
+	r.subscription = c.ActorSystem.EventStream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(*ClusterTopology); ok {
+			r.onTopology(topology)
+		}
+	})
+	c.splitBrainResolver = r
+}
+
+// stopSplitBrainResolver stops the split brain resolver, a pending decision is not made
+func (c *Cluster) stopSplitBrainResolver() {
+	r := c.splitBrainResolver
+	if r == nil {
+		return
+	}
+
+	c.ActorSystem.EventStream.Unsubscribe(r.subscription)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.stopped = true
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+}
+
+func (r *splitBrainResolver) onTopology(topology *ClusterTopology) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.stopped {
+		return
+	}
+
+	r.reachable = topology.Members
+	for _, member := range topology.Left {
+		r.unreachable[member.Id] = member
+	}
+	for _, member := range topology.Joined {
+		delete(r.unreachable, member.Id)
+	}
+
+	// any change of the topology postpones the decision
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	if len(r.unreachable) > 0 {
+		r.timer = time.AfterFunc(r.stableAfter, r.resolve)
+	}
+}
+
+// resolve decides whether this member keeps running, the members which left gracefully are not counted
+func (r *splitBrainResolver) resolve() {
+	partition := &SplitBrainPartition{
+		Self:      r.cluster.MemberList.Members().GetMemberById(r.cluster.ActorSystem.ID),
+		StartedAt: r.startedAt(),
+	}
+	left, err := r.cluster.Gossip.GetState(GracefullyLeftKey)
+
+	r.mutex.Lock()
+	if r.stopped {
+		r.mutex.Unlock()
+		return
+	}
+	if err != nil {
+		// the members which left gracefully would count as lost, decide later
+		r.cluster.Logger().Error("Could not get gracefully left members", slog.Any("error", err))
+		r.timer = time.AfterFunc(r.stableAfter, r.resolve)
+		r.mutex.Unlock()
+		return
+	}
+	for id := range left {
+		delete(r.unreachable, id)
+	}
+	if len(r.unreachable) == 0 {
+		r.mutex.Unlock()
+		return
+	}
+	partition.Reachable = slices.Clone(r.reachable)
+	for _, member := range r.unreachable {
+		partition.Unreachable = append(partition.Unreachable, member)
+	}
+	r.unreachable = map[string]*Member{}
+	r.mutex.Unlock()
+
+	survived := r.strategy.Survives(partition)
+	r.cluster.ActorSystem.EventStream.Publish(&SplitBrainResolved{
+		Survived:    survived,
+		Reachable:   partition.Reachable,
+		Unreachable: partition.Unreachable,
+	})
+
+	if survived {
+		r.cluster.Logger().Info("Split brain resolved, this side keeps running",
+			slog.Int("reachable", len(partition.Reachable)), slog.Int("unreachable", len(partition.Unreachable)))
+		return
+	}
+
+	r.cluster.Logger().Warn("Split brain resolved, stopping this member as it is on the losing side",
+		slog.Int("reachable", len(partition.Reachable)), slog.Int("unreachable", len(partition.Unreachable)))
+	r.cluster.Shutdown(true)
+}
+
+func (r *splitBrainResolver) startedAt() map[string]int64 {
+	startedAt := map[string]int64{}
+	state, err := r.cluster.Gossip.GetState(StartedKey)
+	if err != nil {
+		r.cluster.Logger().Error("Could not get started members", slog.Any("error", err))
+		return startedAt
+	}
+
+	for memberID, value := range state {
+		var started MemberStarted
+		if value.Value != nil && value.Value.UnmarshalTo(&started) == nil {
+			startedAt[memberID] = started.StartedAtUnixMilliseconds
+		}
+	}
+
+	return startedAt
+}
@@ -0,0 +1,93 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func splitBrainMembers(ids ...string) Members {
+	members := make(Members, 0, len(ids))
+	for i, id := range ids {
+		members = append(members, &Member{Id: id, Host: "127.0.0.1", Port: int32(8000 + i)})
+	}
+
+	return members
+}
+
+// split returns the partition of both sides, each seeing the other one as unreachable
+func split(all Members, reachable ...string) (*SplitBrainPartition, *SplitBrainPartition) {
+	side, other := Members{}, Members{}
+	for _, member := range all {
+		if NewMemberSet(splitBrainMembers(reachable...)).ContainsID(member.Id) {
+			side = append(side, member)
+		} else {
+			other = append(other, member)
+		}
+	}
+	startedAt := map[string]int64{}
+	for i, member := range all {
+		startedAt[member.Id] = int64(100 + i)
+	}
+
+	return &SplitBrainPartition{Reachable: side, Unreachable: other, StartedAt: startedAt},
+		&SplitBrainPartition{Reachable: other, Unreachable: side, StartedAt: startedAt}
+}
+
+func TestSplitBrainStrategies_ExactlyOneSideSurvives(t *testing.T) {
+	members := splitBrainMembers("a", "b", "c", "d", "e")
+	tests := []struct {
+		name      string
+		strategy  SplitBrainStrategy
+		reachable []string
+		survives  bool
+	}{
+		{"keep majority, majority", KeepMajority(), []string{"a", "b", "c"}, true},
+		{"keep majority, minority", KeepMajority(), []string{"a", "b"}, false},
+		{"keep oldest, with oldest", KeepOldest(false), []string{"a", "d"}, true},
+		{"keep oldest, without oldest", KeepOldest(false), []string{"b", "c", "d", "e"}, false},
+		{"keep oldest, oldest alone", KeepOldest(true), []string{"a"}, false},
+		{"keep oldest, oldest alone without down if alone", KeepOldest(false), []string{"a"}, true},
+		{"keep referee, with referee", KeepReferee("127.0.0.1:8004", 1), []string{"e"}, true},
+		{"keep referee, without referee", KeepReferee("127.0.0.1:8004", 1), []string{"a", "b", "c", "d"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			side, other := split(members, tt.reachable...)
+			assert.Equal(t, tt.survives, tt.strategy.Survives(side))
+			assert.Equal(t, !tt.survives, tt.strategy.Survives(other))
+		})
+	}
+}
+
+func TestKeepMajority_TieKeepsSideWithLowestAddress(t *testing.T) {
+	side, other := split(splitBrainMembers("a", "b", "c", "d"), "b", "c")
+
+	assert.False(t, KeepMajority().Survives(side))
+	assert.True(t, KeepMajority().Survives(other))
+}
+
+func TestKeepOldest_MemberWithoutStartIsYoungest(t *testing.T) {
+	side, other := split(splitBrainMembers("a", "b", "c"), "a")
+	delete(side.StartedAt, "a")
+
+	assert.False(t, KeepOldest(false).Survives(side))
+	assert.True(t, KeepOldest(false).Survives(other))
+}
+
+func TestStaticQuorum_KeepsSideWithQuorum(t *testing.T) {
+	side, other := split(splitBrainMembers("a", "b", "c", "d", "e"), "a", "b", "c")
+
+	assert.True(t, StaticQuorum(3).Survives(side))
+	assert.False(t, StaticQuorum(3).Survives(other))
+	// with a quorum too large, both sides stop
+	assert.False(t, StaticQuorum(4).Survives(side))
+}
+
+func TestKeepReferee_StopsSideBelowMinimumMembers(t *testing.T) {
+	side, _ := split(splitBrainMembers("a", "b", "c"), "a")
+
+	assert.True(t, KeepReferee("127.0.0.1:8000", 1).Survives(side))
+	assert.False(t, KeepReferee("127.0.0.1:8000", 2).Survives(side))
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2017 - 2022 Asynkron.se <http://www.asynkron.se>
+
+package metrics
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const InternalClusterMetrics string = "internal.cluster.metrics"
+
+type ClusterMetrics struct {
+	// Member load, as gossiped by the members
+	ClusterMemberActivationCount metric.Int64ObservableGauge
+	ClusterMemberMailboxBacklog  metric.Int64ObservableGauge
+	ClusterMemberCPUUsage        metric.Float64ObservableGauge
+
+	// Pub-sub
+	PubSubPublishHistogram      metric.Float64Histogram
+	PubSubBatchSizeHistogram    metric.Int64Histogram
+	PubSubPublishedMessageCount metric.Int64Counter
+	PubSubDeliveredMessageCount metric.Int64Counter
+	PubSubDeliveryFailureCount  metric.Int64Counter
+	PubSubSubscriberCount       metric.Int64ObservableGauge
+	PubSubProducerQueueLength   metric.Int64UpDownCounter
+}
+
+// NewClusterMetrics creates a new ClusterMetrics value and returns a pointer to it
+func NewClusterMetrics(logger *slog.Logger) *ClusterMetrics {
+	meter := otel.Meter(LibName)
+	instruments := ClusterMetrics{}
+
+	var err error
+
+	if instruments.ClusterMemberActivationCount, err = meter.Int64ObservableGauge(
+		"protoactor_cluster_member_activation_count",
+		metric.WithDescription("Number of grains activated on a cluster member, by kind"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterMemberActivationCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterMemberMailboxBacklog, err = meter.Int64ObservableGauge(
+		"protoactor_cluster_member_mailbox_backlog",
+		metric.WithDescription("Number of messages waiting in the mailboxes of the grains of a cluster member"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterMemberMailboxBacklog instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.ClusterMemberCPUUsage, err = meter.Float64ObservableGauge(
+		"protoactor_cluster_member_cpu_usage",
+		metric.WithDescription("Share of the CPU in use by a cluster member"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create ClusterMemberCPUUsage instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubPublishHistogram, err = meter.Float64Histogram(
+		"protoactor_cluster_pubsub_publish_duration_seconds",
+		metric.WithDescription("Duration of publishing a batch to a topic in seconds, until the topic accepted it"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubPublishHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubBatchSizeHistogram, err = meter.Int64Histogram(
+		"protoactor_cluster_pubsub_batch_size",
+		metric.WithDescription("Number of messages per batch published to a topic"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubBatchSizeHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubPublishedMessageCount, err = meter.Int64Counter(
+		"protoactor_cluster_pubsub_published_message_count",
+		metric.WithDescription("Number of messages published to a topic"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubPublishedMessageCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubDeliveredMessageCount, err = meter.Int64Counter(
+		"protoactor_cluster_pubsub_delivered_message_count",
+		metric.WithDescription("Number of messages of a topic delivered to its subscribers"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubDeliveredMessageCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubDeliveryFailureCount, err = meter.Int64Counter(
+		"protoactor_cluster_pubsub_delivery_failure_count",
+		metric.WithDescription("Number of batches of a topic that failed to deliver to a subscriber, by status"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubDeliveryFailureCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubSubscriberCount, err = meter.Int64ObservableGauge(
+		"protoactor_cluster_pubsub_subscriber_count",
+		metric.WithDescription("Number of subscribers of the topics activated on a cluster member"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubSubscriberCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.PubSubProducerQueueLength, err = meter.Int64UpDownCounter(
+		"protoactor_cluster_pubsub_producer_queue_length",
+		metric.WithDescription("Number of messages waiting in the queues of the batching producers of a topic"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create PubSubProducerQueueLength instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	return &instruments
+}
@@ -11,17 +11,21 @@ import (
 const InternalActorMetrics string = "internal.actor.metrics"
 
 type ProtoMetrics struct {
-	mu           sync.Mutex
-	actorMetrics *ActorMetrics
-	knownMetrics map[string]*ActorMetrics
-	logger       *slog.Logger
+	mu                  sync.Mutex
+	actorMetrics        *ActorMetrics
+	knownMetrics        map[string]*ActorMetrics
+	knownRemoteMetrics  map[string]*RemoteMetrics
+	knownClusterMetrics map[string]*ClusterMetrics
+	logger              *slog.Logger
 }
 
 func NewProtoMetrics(logger *slog.Logger) *ProtoMetrics {
 	protoMetrics := ProtoMetrics{
-		actorMetrics: NewActorMetrics(logger),
-		knownMetrics: make(map[string]*ActorMetrics),
-		logger:       logger,
+		actorMetrics:        NewActorMetrics(logger),
+		knownMetrics:        make(map[string]*ActorMetrics),
+		knownRemoteMetrics:  make(map[string]*RemoteMetrics),
+		knownClusterMetrics: make(map[string]*ClusterMetrics),
+		logger:              logger,
 	}
 
 	protoMetrics.Register(InternalActorMetrics, protoMetrics.actorMetrics)
@@ -55,3 +59,61 @@ func (pm *ProtoMetrics) Get(key string) *ActorMetrics {
 
 	return metrics
 }
+
+func (pm *ProtoMetrics) RegisterRemote(key string, instance *RemoteMetrics) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	logger := pm.logger
+
+	if _, ok := pm.knownRemoteMetrics[key]; ok {
+		err := fmt.Errorf("could not register instance %#v of remote metrics, %s already registered", instance, key)
+		logger.Error(err.Error(), slog.Any("error", err))
+		return
+	}
+
+	pm.knownRemoteMetrics[key] = instance
+}
+
+func (pm *ProtoMetrics) GetRemote(key string) *RemoteMetrics {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	metrics, ok := pm.knownRemoteMetrics[key]
+	if !ok {
+		logger := pm.logger
+		err := fmt.Errorf("unknown remote metrics for the given %s key", key)
+		logger.Error(err.Error(), slog.Any("error", err))
+		return nil
+	}
+
+	return metrics
+}
+
+func (pm *ProtoMetrics) RegisterCluster(key string, instance *ClusterMetrics) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	logger := pm.logger
+
+	if _, ok := pm.knownClusterMetrics[key]; ok {
+		err := fmt.Errorf("could not register instance %#v of cluster metrics, %s already registered", instance, key)
+		logger.Error(err.Error(), slog.Any("error", err))
+		return
+	}
+
+	pm.knownClusterMetrics[key] = instance
+}
+
+func (pm *ProtoMetrics) GetCluster(key string) *ClusterMetrics {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	metrics, ok := pm.knownClusterMetrics[key]
+	if !ok {
+		logger := pm.logger
+		err := fmt.Errorf("unknown cluster metrics for the given %s key", key)
+		logger.Error(err.Error(), slog.Any("error", err))
+		return nil
+	}
+
+	return metrics
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2017 - 2022 Asynkron.se <http://www.asynkron.se>
+
+package metrics
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const InternalRemoteMetrics string = "internal.remote.metrics"
+
+type RemoteMetrics struct {
+	// Endpoints
+	RemoteEndpointConnectedCount metric.Int64UpDownCounter
+	RemoteEndpointReconnectCount metric.Int64Counter
+	RemoteWriterMailboxLength    metric.Int64ObservableGauge
+
+	// Batches
+	RemoteBatchSizeHistogram metric.Int64Histogram
+	RemoteBytesSentCount     metric.Int64Counter
+	RemoteBytesReceivedCount metric.Int64Counter
+
+	// Serialization
+	RemoteSerializationHistogram    metric.Float64Histogram
+	RemoteDeserializationHistogram  metric.Float64Histogram
+	RemoteSerializationErrorCount   metric.Int64Counter
+	RemoteDeserializationErrorCount metric.Int64Counter
+}
+
+// NewRemoteMetrics creates a new RemoteMetrics value and returns a pointer to it
+func NewRemoteMetrics(logger *slog.Logger) *RemoteMetrics {
+	meter := otel.Meter(LibName)
+	instruments := RemoteMetrics{}
+
+	var err error
+
+	if instruments.RemoteEndpointConnectedCount, err = meter.Int64UpDownCounter(
+		"protoactor_remote_endpoint_connected_count",
+		metric.WithDescription("Number of connected remote endpoints"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteEndpointConnectedCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteEndpointReconnectCount, err = meter.Int64Counter(
+		"protoactor_remote_endpoint_reconnect_count",
+		metric.WithDescription("Number of reconnect attempts to remote endpoints"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteEndpointReconnectCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteWriterMailboxLength, err = meter.Int64ObservableGauge(
+		"protoactor_remote_writer_mailbox_length",
+		metric.WithDescription("Number of messages waiting to be sent to a remote endpoint"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteWriterMailboxLength instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteBatchSizeHistogram, err = meter.Int64Histogram(
+		"protoactor_remote_batch_size",
+		metric.WithDescription("Number of messages per batch sent to a remote endpoint"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteBatchSizeHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteBytesSentCount, err = meter.Int64Counter(
+		"protoactor_remote_bytes_sent_count",
+		metric.WithDescription("Number of bytes sent to remote endpoints"),
+		metric.WithUnit("By"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteBytesSentCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteBytesReceivedCount, err = meter.Int64Counter(
+		"protoactor_remote_bytes_received_count",
+		metric.WithDescription("Number of bytes received from remote endpoints"),
+		metric.WithUnit("By"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteBytesReceivedCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteSerializationHistogram, err = meter.Float64Histogram(
+		"protoactor_remote_serialization_duration_seconds",
+		metric.WithDescription("Message serialization duration in seconds"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteSerializationHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteDeserializationHistogram, err = meter.Float64Histogram(
+		"protoactor_remote_deserialization_duration_seconds",
+		metric.WithDescription("Message deserialization duration in seconds"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteDeserializationHistogram instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteSerializationErrorCount, err = meter.Int64Counter(
+		"protoactor_remote_serialization_error_count",
+		metric.WithDescription("Number of messages which failed to serialize"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteSerializationErrorCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	if instruments.RemoteDeserializationErrorCount, err = meter.Int64Counter(
+		"protoactor_remote_deserialization_error_count",
+		metric.WithDescription("Number of messages which failed to deserialize"),
+		metric.WithUnit("1"),
+	); err != nil {
+		err = fmt.Errorf("failed to create RemoteDeserializationErrorCount instrument, %w", err)
+		logger.Error(err.Error(), slog.Any("error", err))
+	}
+
+	return &instruments
+}
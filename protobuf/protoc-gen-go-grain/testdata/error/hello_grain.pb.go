@@ -58,6 +58,14 @@ func GetHelloGrainClient(c *cluster.Cluster, id string) *HelloGrainClient {
 	return &HelloGrainClient{Identity: id, cluster: c}
 }
 
+// GetHelloGrainClientFromContext instantiates a new HelloGrainClient with given Identity,
+// its requests are sent with the context of the calling grain so that they share the deadline of the request it processes
+func GetHelloGrainClientFromContext(ctx cluster.GrainContext, id string) *HelloGrainClient {
+	client := GetHelloGrainClient(ctx.Cluster(), id)
+	client.ctx = ctx
+	return client
+}
+
 // GetHelloKind instantiates a new cluster.Kind for Hello
 func GetHelloKind(opts ...actor.PropsOption) *cluster.Kind {
 	props := actor.PropsFromProducer(func() actor.Actor {
@@ -93,6 +101,15 @@ type Hello interface {
 type HelloGrainClient struct {
 	Identity string
 	cluster  *cluster.Cluster
+	ctx      actor.SenderContext
+}
+
+// callOptions sends the requests with the context of the calling grain, unless the options set another one
+func (g *HelloGrainClient) callOptions(opts []cluster.GrainCallOption) []cluster.GrainCallOption {
+	if g.ctx == nil {
+		return opts
+	}
+	return append([]cluster.GrainCallOption{cluster.WithContext(g.ctx)}, opts...)
 }
 
 // SayHello requests the execution on to the cluster with CallOptions
@@ -102,7 +119,7 @@ func (g *HelloGrainClient) SayHello(r *emptypb.Empty, opts ...cluster.GrainCallO
 		return nil, err
 	}
 	reqMsg := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
-	resp, err := g.cluster.Request(g.Identity, "Hello", reqMsg, opts...)
+	resp, err := g.cluster.Request(g.Identity, "Hello", reqMsg, g.callOptions(opts)...)
 	if err != nil {
 		return nil, fmt.Errorf("error request: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sort"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -49,11 +50,15 @@ func (r *Remote) ActivatorForAddress(address string) *actor.PID {
 
 // SpawnFuture spawns a remote actor and returns a Future that completes once the actor is started
 func (r *Remote) SpawnFuture(address, name, kind string, timeout time.Duration) *actor.Future {
-	activator := r.ActivatorForAddress(address)
-	f := r.actorSystem.Root.RequestFuture(activator, &ActorPidRequest{
+	return r.spawnFuture(address, &ActorPidRequest{
 		Name: name,
 		Kind: kind,
 	}, timeout)
+}
+
+func (r *Remote) spawnFuture(address string, request *ActorPidRequest, timeout time.Duration) *actor.Future {
+	activator := r.ActivatorForAddress(address)
+	f := r.actorSystem.Root.RequestFuture(activator, request, timeout)
 	return f
 }
 
@@ -76,6 +81,48 @@ func (r *Remote) SpawnNamed(address, name, kind string, timeout time.Duration) (
 	}
 }
 
+// SpawnNamedWithMessage spawns a named remote actor of a given type at a given address and delivers message to it
+// right after it is started, before any other message, use it to pass activation arguments. When the actor exists,
+// the message is not delivered and the response has the PROCESSNAMEALREADYEXIST status.
+// The message is serialized with the serializer bound to its type.
+func (r *Remote) SpawnNamedWithMessage(address, name, kind string, message interface{}, timeout time.Duration) (*ActorPidResponse, error) {
+	data, typeName, serializerID, err := r.serializers.Serialize(message)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.spawnFuture(address, &ActorPidRequest{
+		Name:                       name,
+		Kind:                       kind,
+		InitialMessageData:         data,
+		InitialMessageTypeName:     typeName,
+		InitialMessageSerializerId: serializerID,
+	}, timeout).Result()
+	if err != nil {
+		return nil, err
+	}
+	switch msg := res.(type) {
+	case *ActorPidResponse:
+		return msg, nil
+	default:
+		return nil, errors.New("remote: Unknown response when remote activating")
+	}
+}
+
+// ListKinds returns the kinds registered on the remote node at the given address
+func (r *Remote) ListKinds(address string, timeout time.Duration) ([]string, error) {
+	res, err := r.actorSystem.Root.RequestFuture(r.ActivatorForAddress(address), &ListKindsRequest{}, timeout).Result()
+	if err != nil {
+		return nil, err
+	}
+	switch msg := res.(type) {
+	case *ListKindsResponse:
+		return msg.Kinds, nil
+	default:
+		return nil, errors.New("remote: Unknown response when listing kinds")
+	}
+}
+
 func newActivatorActor(remote *Remote) actor.Producer {
 	return func() actor.Actor {
 		return &activator{
@@ -90,6 +137,10 @@ func (a *activator) Receive(context actor.Context) {
 		context.Logger().Info("Started Activator")
 	case *Ping:
 		context.Respond(&Pong{})
+	case *ListKindsRequest:
+		kinds := a.remote.GetKnownKinds()
+		sort.Strings(kinds)
+		context.Respond(&ListKindsResponse{Kinds: kinds})
 	case *ActorPidRequest:
 		props, exist := a.remote.kinds[msg.Kind]
 
@@ -102,6 +153,19 @@ func (a *activator) Receive(context actor.Context) {
 			panic(fmt.Errorf("no Props found for kind %s", msg.Kind))
 		}
 
+		var initial interface{}
+		if msg.InitialMessageTypeName != "" {
+			var err error
+			initial, err = a.remote.serializers.Deserialize(msg.InitialMessageData, msg.InitialMessageTypeName, msg.InitialMessageSerializerId)
+			if err != nil {
+				context.Logger().Error("Activator failed to deserialize initial message", slog.String("kind", msg.Kind), slog.Any("error", err))
+				context.Respond(&ActorPidResponse{
+					StatusCode: ResponseStatusCodeERROR.ToInt32(),
+				})
+				return
+			}
+		}
+
 		name := msg.Name
 
 		// unnamed actor, assign auto ExtensionID
@@ -109,6 +173,10 @@ func (a *activator) Receive(context actor.Context) {
 			name = context.ActorSystem().ProcessRegistry.NextId()
 		}
 
+		if initial != nil {
+			props = props.Clone(withInitialMessage(initial))
+		}
+
 		pid, err := context.SpawnNamed(props, "Remote$"+name)
 
 		if err == nil {
@@ -141,3 +209,18 @@ func (a *activator) Receive(context actor.Context) {
 		context.Logger().Error("Activator received unknown message", slog.Any("message", msg))
 	}
 }
+
+// withInitialMessage delivers the message to the actor right after its first Started message,
+// before the actor processes any message of its mailbox
+func withInitialMessage(message interface{}) actor.PropsOption {
+	return actor.WithReceiverMiddleware(func(next actor.ReceiverFunc) actor.ReceiverFunc {
+		delivered := false
+		return func(c actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+			next(c, envelope)
+			if _, ok := envelope.Message.(*actor.Started); ok && !delivered {
+				delivered = true
+				next(c, actor.WrapEnvelope(message))
+			}
+		}
+	})
+}
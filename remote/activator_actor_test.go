@@ -0,0 +1,93 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemote_SpawnNamedWithMessage(t *testing.T) {
+	received := make(chan interface{}, 2)
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(actor.SystemMessage); !ok {
+			received <- ctx.Message()
+		}
+	})
+
+	system1 := actor.NewActorSystem()
+	remote1 := NewRemote(system1, Configure("localhost", 0))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	system2 := actor.NewActorSystem()
+	remote2 := NewRemote(system2, Configure("localhost", 0, WithKinds(NewKind("someKind", props), NewKind("otherKind", props))))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	res, err := remote1.SpawnNamedWithMessage(system2.Address(), "withArgs", "someKind", &actor.PID{Id: "args"}, 5*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, res.Pid)
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "args", msg.(*actor.PID).Id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("initial message was not delivered")
+	}
+
+	res, err = remote1.SpawnNamedWithMessage(system2.Address(), "withArgs", "someKind", &actor.PID{Id: "again"}, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, ResponseStatusCodePROCESSNAMEALREADYEXIST.ToInt32(), res.StatusCode)
+
+	select {
+	case msg := <-received:
+		t.Fatalf("existing actor received %v", msg)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestRemote_InitialMessageIsReceivedFirst(t *testing.T) {
+	received := make(chan interface{}, 2)
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *actor.Started:
+			// the message is in the mailbox before the initial message is delivered
+			ctx.Send(ctx.Self(), "sent")
+		case string:
+			received <- msg
+		}
+	}, withInitialMessage("initial"))
+
+	system := actor.NewActorSystem()
+	system.Root.Spawn(props)
+
+	for _, expected := range []string{"initial", "sent"} {
+		select {
+		case msg := <-received:
+			assert.Equal(t, expected, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s was not received", expected)
+		}
+	}
+}
+
+func TestRemote_ListKinds(t *testing.T) {
+	props := actor.PropsFromFunc(func(ctx actor.Context) {})
+
+	system1 := actor.NewActorSystem()
+	remote1 := NewRemote(system1, Configure("localhost", 0))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	system2 := actor.NewActorSystem()
+	remote2 := NewRemote(system2, Configure("localhost", 0, WithKinds(NewKind("someKind", props), NewKind("otherKind", props))))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	kinds, err := remote1.ListKinds(system2.Address(), 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"otherKind", "someKind"}, kinds)
+}
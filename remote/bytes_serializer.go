@@ -0,0 +1,33 @@
+package remote
+
+import "fmt"
+
+const bytesTypeName = "bytes"
+
+// bytesSerializer sends []byte messages as they are
+type bytesSerializer struct{}
+
+func newBytesSerializer() *bytesSerializer {
+	return &bytesSerializer{}
+}
+
+func (bytesSerializer) Serialize(msg interface{}) ([]byte, error) {
+	if b, ok := msg.([]byte); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("msg must be []byte")
+}
+
+func (bytesSerializer) Deserialize(typeName string, bytes []byte) (interface{}, error) {
+	if typeName != bytesTypeName {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTypeName, typeName)
+	}
+	return bytes, nil
+}
+
+func (bytesSerializer) GetTypeName(msg interface{}) (string, error) {
+	if _, ok := msg.([]byte); ok {
+		return bytesTypeName, nil
+	}
+	return "", fmt.Errorf("msg must be []byte")
+}
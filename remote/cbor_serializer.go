@@ -0,0 +1,100 @@
+package remote
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// CborSerializer serializes plain Go values as CBOR.
+// Types have to be registered before they can be sent or received, fields are matched by name
+// so adding or removing fields stays compatible with peers running an older version of a type.
+type CborSerializer struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+	names map[reflect.Type]string
+}
+
+// NewCborSerializer creates a CBOR serializer without any registered types
+func NewCborSerializer() *CborSerializer {
+	return &CborSerializer{
+		types: make(map[string]reflect.Type),
+		names: make(map[reflect.Type]string),
+	}
+}
+
+// RegisterType registers the type of msg, it is written on the wire using the first name
+// and read back from any of the names. Without names the Go type name is used.
+func (c *CborSerializer) RegisterType(msg interface{}, names ...string) {
+	t := reflect.TypeOf(msg)
+	if len(names) == 0 {
+		names = []string{t.String()}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.names[t] = names[0]
+	for _, name := range names {
+		c.types[name] = t
+	}
+}
+
+func (c *CborSerializer) Serialize(msg interface{}) ([]byte, error) {
+	if _, err := c.GetTypeName(msg); err != nil {
+		return nil, err
+	}
+	return cbor.Marshal(msg)
+}
+
+func (c *CborSerializer) Deserialize(typeName string, bytes []byte) (interface{}, error) {
+	c.mu.RLock()
+	t, ok := c.types[typeName]
+	c.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTypeName, typeName)
+	}
+
+	if t.Kind() == reflect.Pointer {
+		v := reflect.New(t.Elem())
+		if err := cbor.Unmarshal(bytes, v.Interface()); err != nil {
+			return nil, err
+		}
+		return v.Interface(), nil
+	}
+
+	v := reflect.New(t)
+	if err := cbor.Unmarshal(bytes, v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+func (c *CborSerializer) GetTypeName(msg interface{}) (string, error) {
+	c.mu.RLock()
+	name, ok := c.names[reflect.TypeOf(msg)]
+	c.mu.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("%w: %T", ErrNoSerializerForType, msg)
+	}
+	return name, nil
+}
+
+// CloneSerializer returns a copy of the serializer with the same registered types
+func (c *CborSerializer) CloneSerializer() Serializer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cc := NewCborSerializer()
+	for name, t := range c.types {
+		cc.types[name] = t
+	}
+	for t, name := range c.names {
+		cc.names[t] = name
+	}
+	return cc
+}
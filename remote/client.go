@@ -0,0 +1,132 @@
+package remote
+
+import (
+	"errors"
+	"io/ioutil"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"google.golang.org/grpc/grpclog"
+)
+
+// clientAddressPrefix marks the address of a client, which has no server and is reached through the
+// streams it opened to the servers it connected to
+const clientAddressPrefix = "client:"
+
+// ClientAddress returns the address of the client actor system with the id
+func ClientAddress(systemID string) string {
+	return clientAddressPrefix + systemID
+}
+
+// IsClientAddress reports whether the address is the address of a client
+func IsClientAddress(address string) bool {
+	return strings.HasPrefix(address, clientAddressPrefix)
+}
+
+// StartClient starts the remote without a server: it only connects to other systems, which send their
+// messages back over the same connections, so it needs no inbound port and works behind NAT.
+// The actor system gets a client address, which only the systems it connected to can reach.
+func (r *Remote) StartClient() {
+	grpclog.SetLoggerV2(grpclog.NewLoggerV2(ioutil.Discard, ioutil.Discard, ioutil.Discard))
+
+	address := ClientAddress(r.actorSystem.ID)
+	r.actorSystem.ProcessRegistry.RegisterAddressResolver(r.remoteHandler)
+	r.actorSystem.ProcessRegistry.Address = address
+	r.client = true
+	r.Logger().Info("Starting remote client", slog.String("address", address))
+
+	r.edpManager = newEndpointManager(r)
+	r.edpManager.start()
+	// delivers the messages the servers send back over the connections
+	r.edpReader = newEndpointReader(r)
+}
+
+// errClientConnected refuses a client connection while another stream of the same client is alive
+var errClientConnected = errors.New("client is connected already")
+
+// lockedStream serializes the writes to a stream shared by the endpoint reader and a client endpoint writer
+type lockedStream struct {
+	mutex  sync.Mutex
+	stream Remoting_ReceiveServer
+	closed atomic.Bool // set once the endpoint reader stopped reading the stream
+}
+
+func (s *lockedStream) Send(msg *RemoteMessage) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.stream.Send(msg)
+}
+
+// clientEndpoint asks the endpoint supervisor for the endpoint writing to a connected client
+type clientEndpoint struct {
+	address string
+	stream  remoteStream
+}
+
+// clientConnected makes the messages to the client address go to the stream the client opened
+func (em *endpointManager) clientConnected(address string, stream remoteStream) {
+	res, err := em.remote.actorSystem.Root.RequestFuture(em.endpointSupervisor, &clientEndpoint{address: address, stream: stream}, -1).Result()
+	if err != nil {
+		em.remote.Logger().Error("EndpointManager failed to start client endpoint", slog.String("address", address), slog.Any("error", err))
+		return
+	}
+
+	el := NewEndpointLazy(em, address)
+	el.once.Do(func() {})
+	el.Set(res.(*endpoint))
+	if previous, loaded := em.connections.Swap(address, el); loaded {
+		// the client reconnected after its old stream ended, the endpoint of the old stream is done
+		ep := previous.(*endpointLazy).endpoint.Load()
+		if ep, ok := ep.(*endpoint); ok {
+			em.remote.actorSystem.Root.Send(ep.writer, &EndpointTerminatedEvent{Address: address})
+			em.remote.actorSystem.Root.Stop(ep.watcher)
+		}
+	}
+}
+
+// clientStreamAlive reports whether the client is connected through a stream which is still read
+func (em *endpointManager) clientStreamAlive(address string) bool {
+	v, ok := em.connections.Load(address)
+	if !ok {
+		return false
+	}
+
+	ep, ok := v.(*endpointLazy).endpoint.Load().(*endpoint)
+	if !ok {
+		return false
+	}
+	stream, ok := ep.clientStream.(*lockedStream)
+
+	return ok && !stream.closed.Load()
+}
+
+// clientDisconnected terminates the endpoint of the client if it still writes to the stream
+func (em *endpointManager) clientDisconnected(address string, stream remoteStream) {
+	v, ok := em.connections.Load(address)
+	if !ok {
+		return
+	}
+
+	ep, ok := v.(*endpointLazy).endpoint.Load().(*endpoint)
+	if !ok || ep.clientStream != stream {
+		return
+	}
+
+	em.remote.actorSystem.EventStream.Publish(&EndpointTerminatedEvent{Address: address})
+}
+
+// deadLetterClient answers the messages to a client that is not connected, clients cannot be dialed
+func (em *endpointManager) deadLetterClient(target *actor.PID, message interface{}, sender *actor.PID) {
+	em.remote.actorSystem.EventStream.Publish(&actor.DeadLetterEvent{
+		PID:     target,
+		Message: message,
+		Sender:  sender,
+	})
+	if sender != nil {
+		em.remote.actorSystem.Root.Send(sender, &actor.DeadLetterResponse{Target: target})
+	}
+}
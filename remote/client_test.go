@@ -0,0 +1,97 @@
+package remote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRemote_StartClient(t *testing.T) {
+	server := actor.NewActorSystem()
+	serverRemote := NewRemote(server, Configure("localhost", 0))
+	serverRemote.Start()
+	defer serverRemote.Shutdown(true)
+
+	echo, err := server.Root.SpawnNamed(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*wrapperspb.StringValue); ok {
+			ctx.Respond(&wrapperspb.StringValue{Value: msg.Value + " from " + ctx.Sender().Address})
+		}
+	}), "echo")
+	require.NoError(t, err)
+
+	client := actor.NewActorSystem()
+	clientRemote := NewRemote(client, Configure("localhost", 0))
+	clientRemote.StartClient()
+	defer clientRemote.Shutdown(true)
+
+	assert.True(t, IsClientAddress(client.Address()))
+	assert.Equal(t, ClientAddress(client.ID), client.Address())
+
+	for i := 0; i < 3; i++ {
+		res, err := client.Root.RequestFuture(echo, &wrapperspb.StringValue{Value: "hello"}, 5*time.Second).Result()
+		require.NoError(t, err)
+		assert.Equal(t, "hello from "+client.Address(), res.(*wrapperspb.StringValue).Value)
+	}
+
+	// once the client is gone the server cannot reach it anymore
+	clientRemote.Shutdown(true)
+	require.Eventually(t, func() bool {
+		_, connected := serverRemote.edpManager.connections.Load(client.Address())
+		return !connected
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = server.Root.RequestFuture(actor.NewPID(client.Address(), "none"), &wrapperspb.StringValue{}, time.Second).Result()
+	assert.ErrorIs(t, err, actor.ErrDeadLetter)
+}
+
+func TestRemote_RefusesSecondStreamOfConnectedClient(t *testing.T) {
+	server := actor.NewActorSystem()
+	serverRemote := NewRemote(server, Configure("localhost", 0))
+	serverRemote.Start()
+	defer serverRemote.Shutdown(true)
+
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	connect := func() (Remoting_ReceiveClient, context.CancelFunc, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := NewRemotingClient(conn).Receive(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&RemoteMessage{MessageType: &RemoteMessage_ConnectRequest{
+			ConnectRequest: &ConnectRequest{ConnectionType: &ConnectRequest_ClientConnection{
+				ClientConnection: &ClientConnection{SystemId: "client"},
+			}},
+		}}))
+		_, err = stream.Recv()
+		return stream, cancel, err
+	}
+
+	_, cancel, err := connect()
+	require.NoError(t, err)
+
+	// another stream of the same system id does not take the client over
+	_, cancelSecond, err := connect()
+	defer cancelSecond()
+	assert.Error(t, err)
+
+	disconnected := func() bool {
+		_, connected := serverRemote.edpManager.connections.Load(ClientAddress("client"))
+		return !connected
+	}
+
+	// once the first stream is gone the client connects again
+	cancel()
+	require.Eventually(t, disconnected, 5*time.Second, 50*time.Millisecond)
+	_, cancel, err = connect()
+	assert.NoError(t, err)
+
+	cancel()
+	require.Eventually(t, disconnected, 5*time.Second, 50*time.Millisecond)
+}
@@ -1,6 +1,10 @@
 package remote
 
-import "google.golang.org/grpc"
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
 
 type ConfigOption func(config *Config)
 
@@ -32,6 +36,56 @@ func WithEndpointManagerQueueSize(queueSize int) ConfigOption {
 	}
 }
 
+// WithMaxRetryCount sets the number of reconnect attempts before an endpoint is terminated
+func WithMaxRetryCount(count int) ConfigOption {
+	return func(config *Config) {
+		config.MaxRetryCount = count
+	}
+}
+
+// WithRetryBackoff sets the initial and the max delay between reconnect attempts
+func WithRetryBackoff(backoff, maxBackoff time.Duration) ConfigOption {
+	return func(config *Config) {
+		config.RetryBackoff = backoff
+		config.RetryMaxBackoff = maxBackoff
+	}
+}
+
+// WithReconnectBufferSize sets the number of outbound messages held back while reconnecting
+func WithReconnectBufferSize(size int) ConfigOption {
+	return func(config *Config) {
+		config.ReconnectBufferSize = size
+	}
+}
+
+// WithQuarantineDuration enables the quarantine of an address for the duration after its endpoint has been terminated
+func WithQuarantineDuration(duration time.Duration) ConfigOption {
+	return func(config *Config) {
+		config.QuarantineDuration = duration
+	}
+}
+
+// WithMaxMessageSize sets the maximum size of a single serialized message
+func WithMaxMessageSize(size int) ConfigOption {
+	return func(config *Config) {
+		config.MaxMessageSize = size
+	}
+}
+
+// WithMessageChunkSize sets the size above which messages are sent in chunks
+func WithMessageChunkSize(size int) ConfigOption {
+	return func(config *Config) {
+		config.MessageChunkSize = size
+	}
+}
+
+// WithSerializers sets the serializer registry for the remote
+func WithSerializers(serializers *SerializerRegistry) ConfigOption {
+	return func(config *Config) {
+		config.Serializers = serializers
+	}
+}
+
 // WithDialOptions sets the dial options for the remote
 func WithDialOptions(options ...grpc.DialOption) ConfigOption {
 	return func(config *Config) {
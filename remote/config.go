@@ -2,6 +2,7 @@ package remote
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
 	"google.golang.org/grpc"
@@ -17,6 +18,11 @@ func defaultConfig() *Config {
 		EndpointManagerQueueSize: 1000000,
 		Kinds:                    make(map[string]*actor.Props),
 		MaxRetryCount:            5,
+		RetryBackoff:             100 * time.Millisecond,
+		RetryMaxBackoff:          5 * time.Second,
+		ReconnectBufferSize:      10000,
+		MaxMessageSize:           64 * 1024 * 1024,
+		MessageChunkSize:         1024 * 1024,
 	}
 }
 
@@ -55,5 +61,25 @@ type Config struct {
 	EndpointManagerBatchSize int
 	EndpointManagerQueueSize int
 	Kinds                    map[string]*actor.Props
-	MaxRetryCount            int
+	// MaxRetryCount is the number of reconnect attempts before an endpoint is terminated
+	MaxRetryCount int
+	// RetryBackoff is the delay before the first reconnect attempt, it doubles with every attempt up to RetryMaxBackoff
+	RetryBackoff    time.Duration
+	RetryMaxBackoff time.Duration
+	// ReconnectBufferSize is the number of outbound messages held back while reconnecting, the overflow goes to dead letters
+	ReconnectBufferSize int
+	// QuarantineDuration is how long messages to an address are dead lettered after its endpoint has been terminated.
+	// The quarantine is by address, a system restarted on the same address is unreachable until it ends.
+	// Zero, the default, disables the quarantine.
+	QuarantineDuration time.Duration
+	// MaxMessageSize is the hard limit, in bytes, for a single serialized message, whether it is chunked or not.
+	// Larger messages are rejected and the sender receives a *MessageTooLargeError,
+	// a request future fails with it as its error.
+	MaxMessageSize int
+	// MessageChunkSize is the size, in bytes, above which a serialized message is
+	// split into chunks instead of being sent as part of a regular batch.
+	// Batches are kept below it as well, or below the default gRPC receive limit of 4MB when it is 0.
+	MessageChunkSize int
+	// Serializers is the serializer registry of the remote, a copy of DefaultSerializers is used when nil
+	Serializers *SerializerRegistry
 }
@@ -0,0 +1,30 @@
+package remote
+
+import (
+	"strconv"
+	"time"
+)
+
+// DeadlineHeader is the message header holding a deadline, in unix milliseconds of the local clock.
+// On the wire it holds the milliseconds remaining until the deadline instead, the receiving system rebases them
+// on its own clock, so that deadlines do not depend on the clocks of the systems being in sync.
+// The time the message spends on the network is not taken off.
+const DeadlineHeader = "remote-deadline"
+
+// deadlineToWire replaces the deadline of the header with the time remaining until it
+func deadlineToWire(header map[string]string) {
+	if value, ok := header[DeadlineHeader]; ok {
+		if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+			header[DeadlineHeader] = strconv.FormatInt(time.Until(time.UnixMilli(ms)).Milliseconds(), 10)
+		}
+	}
+}
+
+// deadlineFromWire replaces the time remaining in the header with the deadline on the local clock
+func deadlineFromWire(header map[string]string) {
+	if value, ok := header[DeadlineHeader]; ok {
+		if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+			header[DeadlineHeader] = strconv.FormatInt(time.Now().Add(time.Duration(ms)*time.Millisecond).UnixMilli(), 10)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package remote
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadline_SentAsTimeRemaining(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	header := map[string]string{DeadlineHeader: strconv.FormatInt(deadline.UnixMilli(), 10), "deadline": "42"}
+
+	deadlineToWire(header)
+	remaining, err := strconv.ParseInt(header[DeadlineHeader], 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour.Milliseconds(), remaining, 1000)
+	assert.Equal(t, "42", header["deadline"], "headers of the user are not rewritten")
+
+	// the receiver rebases the time remaining on its own clock
+	deadlineFromWire(header)
+	rebased, err := strconv.ParseInt(header[DeadlineHeader], 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, deadline.UnixMilli(), rebased, 1000)
+}
+
+func TestDeadline_ExpiredStaysExpired(t *testing.T) {
+	header := map[string]string{DeadlineHeader: strconv.FormatInt(time.Now().Add(-time.Second).UnixMilli(), 10)}
+
+	deadlineToWire(header)
+	deadlineFromWire(header)
+	rebased, err := strconv.ParseInt(header[DeadlineHeader], 10, 64)
+	require.NoError(t, err)
+	assert.True(t, time.UnixMilli(rebased).Before(time.Now()))
+}
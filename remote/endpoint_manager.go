@@ -46,8 +46,9 @@ func (el *endpointLazy) Get() *endpoint {
 }
 
 type endpoint struct {
-	writer  *actor.PID
-	watcher *actor.PID
+	writer       *actor.PID
+	watcher      *actor.PID
+	clientStream remoteStream // the stream the client opened, if the endpoint is a client
 }
 
 func (ep *endpoint) Address() string {
@@ -62,6 +63,7 @@ type endpointManager struct {
 	activator                 *actor.PID
 	stopped                   bool
 	endpointReaderConnections *sync.Map
+	quarantined               *sync.Map // address -> time.Time the quarantine ends
 }
 
 func newEndpointManager(r *Remote) *endpointManager {
@@ -70,6 +72,7 @@ func newEndpointManager(r *Remote) *endpointManager {
 		remote:                    r,
 		stopped:                   false,
 		endpointReaderConnections: &sync.Map{},
+		quarantined:               &sync.Map{},
 	}
 }
 
@@ -170,12 +173,36 @@ func (em *endpointManager) endpointEvent(evn interface{}) {
 	}
 }
 
+// quarantine makes the manager refuse connections to the address for the configured QuarantineDuration
+func (em *endpointManager) quarantine(address string) {
+	if em.remote.config.QuarantineDuration <= 0 {
+		return
+	}
+	em.remote.Logger().Warn("EndpointManager quarantining address", slog.String("address", address), slog.Duration("duration", em.remote.config.QuarantineDuration))
+	em.quarantined.Store(address, time.Now().Add(em.remote.config.QuarantineDuration))
+}
+
+func (em *endpointManager) isQuarantined(address string) bool {
+	v, ok := em.quarantined.Load(address)
+	if !ok {
+		return false
+	}
+	if time.Now().Before(v.(time.Time)) {
+		return true
+	}
+	em.quarantined.Delete(address)
+	return false
+}
+
 func (em *endpointManager) remoteTerminate(msg *remoteTerminate) {
-	if em.stopped {
+	if em.stopped || em.isQuarantined(msg.Watchee.Address) {
 		return
 	}
 	address := msg.Watchee.Address
-	endpoint := em.ensureConnected(address)
+	endpoint, ok := em.connected(address)
+	if !ok {
+		return
+	}
 	em.remote.actorSystem.Root.Send(endpoint.watcher, msg)
 }
 
@@ -183,22 +210,35 @@ func (em *endpointManager) remoteWatch(msg *remoteWatch) {
 	if em.stopped {
 		return
 	}
+	if em.isQuarantined(msg.Watchee.Address) || em.isDisconnectedClient(msg.Watchee.Address) {
+		// the address is gone, answer right away instead of connecting again
+		if ref, ok := em.remote.actorSystem.ProcessRegistry.GetLocal(msg.Watcher.Id); ok {
+			ref.SendSystemMessage(msg.Watcher, &actor.Terminated{
+				Who: msg.Watchee,
+				Why: actor.TerminatedReason_AddressTerminated,
+			})
+		}
+		return
+	}
 	address := msg.Watchee.Address
 	endpoint := em.ensureConnected(address)
 	em.remote.actorSystem.Root.Send(endpoint.watcher, msg)
 }
 
 func (em *endpointManager) remoteUnwatch(msg *remoteUnwatch) {
-	if em.stopped {
+	if em.stopped || em.isQuarantined(msg.Watchee.Address) {
 		return
 	}
 	address := msg.Watchee.Address
-	endpoint := em.ensureConnected(address)
+	endpoint, ok := em.connected(address)
+	if !ok {
+		return
+	}
 	em.remote.actorSystem.Root.Send(endpoint.watcher, msg)
 }
 
 func (em *endpointManager) remoteDeliver(msg *remoteDeliver) {
-	if em.stopped {
+	if em.stopped || em.isQuarantined(msg.target.Address) {
 		// send to deadletter
 		em.remote.actorSystem.EventStream.Publish(&actor.DeadLetterEvent{
 			PID:     msg.target,
@@ -208,10 +248,37 @@ func (em *endpointManager) remoteDeliver(msg *remoteDeliver) {
 		return
 	}
 	address := msg.target.Address
-	endpoint := em.ensureConnected(address)
+	endpoint, ok := em.connected(address)
+	if !ok {
+		em.deadLetterClient(msg.target, msg.message, msg.sender)
+		return
+	}
 	em.remote.actorSystem.Root.Send(endpoint.writer, msg)
 }
 
+// isDisconnectedClient reports whether the address is a client which is not connected to this system
+func (em *endpointManager) isDisconnectedClient(address string) bool {
+	if !IsClientAddress(address) {
+		return false
+	}
+	_, ok := em.connections.Load(address)
+	return !ok
+}
+
+// connected returns the endpoint of the address, connecting to it unless it is a client:
+// clients connect by themselves and cannot be dialed
+func (em *endpointManager) connected(address string) (*endpoint, bool) {
+	if IsClientAddress(address) {
+		e, ok := em.connections.Load(address)
+		if !ok {
+			return nil, false
+		}
+		return e.(*endpointLazy).Get(), true
+	}
+
+	return em.ensureConnected(address), true
+}
+
 func (em *endpointManager) ensureConnected(address string) *endpoint {
 	e, ok := em.connections.Load(address)
 	if !ok {
@@ -269,7 +336,9 @@ func newEndpointSupervisor(remote *Remote) actor.Actor {
 }
 
 func (state *endpointSupervisor) Receive(ctx actor.Context) {
-	if address, ok := ctx.Message().(string); ok {
+	switch msg := ctx.Message().(type) {
+	case string:
+		address := msg
 		ctx.Logger().Debug("EndpointSupervisor spawning EndpointWriter and EndpointWatcher", slog.String("address", address))
 		e := &endpoint{
 			writer:  state.spawnEndpointWriter(state.remote, address, ctx),
@@ -277,6 +346,15 @@ func (state *endpointSupervisor) Receive(ctx actor.Context) {
 		}
 		ctx.Logger().Debug("id", slog.String("ewr", e.writer.Id), slog.String("ewa", e.watcher.Id))
 		ctx.Respond(e)
+	case *clientEndpoint:
+		ctx.Logger().Debug("EndpointSupervisor spawning EndpointWriter and EndpointWatcher for client", slog.String("address", msg.address))
+		props := actor.PropsFromProducer(clientEndpointWriterProducer(state.remote, msg.address, state.remote.config, msg.stream),
+			actor.WithMailbox(endpointWriterMailboxProducer(state.remote.config.EndpointWriterBatchSize, state.remote.config.EndpointWriterQueueSize)))
+		ctx.Respond(&endpoint{
+			writer:       ctx.Spawn(props),
+			watcher:      state.spawnEndpointWatcher(state.remote, msg.address, ctx),
+			clientStream: msg.stream,
+		})
 	}
 }
 
@@ -290,9 +368,14 @@ func (state *endpointSupervisor) HandleFailure(actorSystem *actor.ActorSystem, s
 }
 
 func (state *endpointSupervisor) spawnEndpointWriter(remote *Remote, address string, ctx actor.Context) *actor.PID {
+	mailboxProducer := endpointWriterMailboxProducer(remote.config.EndpointWriterBatchSize, remote.config.EndpointWriterQueueSize)
 	props := actor.
 		PropsFromProducer(endpointWriterProducer(remote, address, remote.config),
-			actor.WithMailbox(endpointWriterMailboxProducer(remote.config.EndpointWriterBatchSize, remote.config.EndpointWriterQueueSize)))
+			actor.WithMailbox(func() actor.Mailbox {
+				mb := mailboxProducer()
+				remote.metrics.trackMailbox(address, mb)
+				return mb
+			}))
 	pid := ctx.Spawn(props)
 	return pid
 }
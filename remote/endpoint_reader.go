@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -36,6 +37,8 @@ func newEndpointReader(r *Remote) *endpointReader {
 }
 
 func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
+	// the endpoint writer of a client sends to the stream as well
+	out := &lockedStream{stream: stream}
 	disconnectChan := make(chan bool, 1)
 	s.remote.edpManager.endpointReaderConnections.Store(stream, disconnectChan)
 	defer func() {
@@ -47,7 +50,7 @@ func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
 		// endpointReader sends false
 		if <-disconnectChan {
 			s.remote.Logger().Debug("EndpointReader is telling to remote that it's leaving")
-			err := stream.Send(&RemoteMessage{
+			err := out.Send(&RemoteMessage{
 				MessageType: &RemoteMessage_DisconnectRequest{
 					DisconnectRequest: &DisconnectRequest{},
 				},
@@ -61,6 +64,17 @@ func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
 		}
 	}()
 
+	chunks := newChunkAssembler(s.remote.config.MaxMessageSize)
+	// address of the connected remote, known after the connect request
+	var address string
+	client := false
+	defer func() {
+		out.closed.Store(true)
+		if client {
+			s.remote.edpManager.clientDisconnected(address, out)
+		}
+	}()
+
 	for {
 		msg, err := stream.Recv()
 		switch {
@@ -75,18 +89,39 @@ func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
 			continue
 		}
 
+		if s.remote.metrics.enabled() {
+			s.remote.metrics.bytesReceived(address, proto.Size(msg))
+		}
+
 		switch t := msg.MessageType.(type) {
 		case *RemoteMessage_ConnectRequest:
 			s.remote.Logger().Debug("EndpointReader received connect request", slog.Any("message", t.ConnectRequest))
 			c := t.ConnectRequest
-			_, err := s.OnConnectRequest(stream, c)
+			address = c.GetServerConnection().GetAddress()
+			if cc := c.GetClientConnection(); cc != nil {
+				address, client = ClientAddress(cc.SystemId), true
+			}
+			_, err := s.OnConnectRequest(out, c)
 			if err != nil {
 				s.remote.Logger().Error("EndpointReader failed to handle connect request", slog.Any("error", err))
 				return err
 			}
 		case *RemoteMessage_MessageBatch:
 			m := t.MessageBatch
-			err := s.onMessageBatch(m)
+			err := s.onMessageBatch(m, address)
+			if err != nil {
+				return err
+			}
+		case *RemoteMessage_MessageChunk:
+			m, err := chunks.add(t.MessageChunk)
+			if err != nil {
+				s.remote.Logger().Error("EndpointReader dropped chunked message", slog.Any("error", err))
+				continue
+			}
+			if m == nil {
+				continue
+			}
+			err = s.onMessageBatch(m, address)
 			if err != nil {
 				return err
 			}
@@ -98,7 +133,7 @@ func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
 	}
 }
 
-func (s *endpointReader) OnConnectRequest(stream Remoting_ReceiveServer, c *ConnectRequest) (bool, error) {
+func (s *endpointReader) OnConnectRequest(stream remoteStream, c *ConnectRequest) (bool, error) {
 	switch tt := c.ConnectionType.(type) {
 	case *ConnectRequest_ServerConnection:
 		{
@@ -107,8 +142,10 @@ func (s *endpointReader) OnConnectRequest(stream Remoting_ReceiveServer, c *Conn
 		}
 	case *ConnectRequest_ClientConnection:
 		{
-			// TODO implement me
-			s.remote.Logger().Error("ClientConnection not implemented")
+			cc := tt.ClientConnection
+			if err := s.onClientConnection(stream, cc); err != nil {
+				return true, err
+			}
 		}
 	default:
 		s.remote.Logger().Error("EndpointReader received unknown connection type")
@@ -117,7 +154,7 @@ func (s *endpointReader) OnConnectRequest(stream Remoting_ReceiveServer, c *Conn
 	return false, nil
 }
 
-func (s *endpointReader) onMessageBatch(m *MessageBatch) error {
+func (s *endpointReader) onMessageBatch(m *MessageBatch, address string) error {
 	var (
 		sender *actor.PID
 		target *actor.PID
@@ -133,7 +170,10 @@ func (s *endpointReader) onMessageBatch(m *MessageBatch) error {
 			return errors.New("unknown target")
 		}
 
-		message, err := Deserialize(data, m.TypeNames[envelope.TypeId], envelope.SerializerId)
+		typeName := m.TypeNames[envelope.TypeId]
+		start := time.Now()
+		message, err := s.remote.serializers.Deserialize(data, typeName, envelope.SerializerId)
+		s.remote.metrics.deserialized(address, typeName, start, err)
 		if err != nil {
 			s.remote.Logger().Error("EndpointReader failed to deserialize", slog.Any("error", err))
 			return err
@@ -142,7 +182,7 @@ func (s *endpointReader) onMessageBatch(m *MessageBatch) error {
 		// translate from on-the-wire representation to in-process representation
 		// this only applies to root level messages, and never on nested child messages
 		if v, ok := message.(RootSerialized); ok {
-			message, err = v.Deserialize()
+			message, err = v.Deserialize(s.remote.serializers)
 			if err != nil {
 				s.remote.Logger().Error("EndpointReader failed to deserialize", slog.Any("error", err))
 				return err
@@ -171,6 +211,7 @@ func (s *endpointReader) onMessageBatch(m *MessageBatch) error {
 			// slow path
 			if envelope.MessageHeader != nil {
 				header = envelope.MessageHeader.HeaderData
+				deadlineFromWire(header)
 			}
 			localEnvelope := &actor.MessageEnvelope{
 				Header:  header,
@@ -210,7 +251,38 @@ func deserializeTarget(pid *actor.PID, index int32, requestId uint32, arr []*act
 	return pid
 }
 
-func (s *endpointReader) onServerConnection(stream Remoting_ReceiveServer, sc *ServerConnection) {
+// onClientConnection answers the client and writes the messages to its address back to the stream it opened.
+// Another stream of the same system id is refused while the first one is alive, it would take over the client's messages.
+func (s *endpointReader) onClientConnection(stream remoteStream, cc *ClientConnection) error {
+	address := ClientAddress(cc.SystemId)
+	if s.remote.edpManager.clientStreamAlive(address) {
+		s.remote.Logger().Warn("EndpointReader refused client connection, the client is connected through another stream", slog.String("address", address))
+		return errClientConnected
+	}
+
+	blocked := s.remote.BlockList().IsBlocked(cc.SystemId)
+	err := stream.Send(
+		&RemoteMessage{
+			MessageType: &RemoteMessage_ConnectResponse{
+				ConnectResponse: &ConnectResponse{
+					Blocked:  blocked,
+					MemberId: s.remote.actorSystem.ID,
+				},
+			},
+		})
+	if err != nil {
+		s.remote.Logger().Error("EndpointReader failed to send ConnectResponse message", slog.Any("error", err))
+		return nil
+	}
+
+	if !blocked {
+		s.remote.edpManager.clientConnected(address, stream)
+	}
+
+	return nil
+}
+
+func (s *endpointReader) onServerConnection(stream remoteStream, sc *ServerConnection) {
 	if s.remote.BlockList().IsBlocked(sc.SystemId) {
 		s.remote.Logger().Debug("EndpointReader is blocked")
 
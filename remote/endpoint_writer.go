@@ -2,6 +2,7 @@ package remote
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"time"
@@ -12,6 +13,9 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// grpcDefaultMaxRecvMsgSize is the size of the largest message a gRPC server receives unless configured otherwise
+const grpcDefaultMaxRecvMsgSize = 4 * 1024 * 1024
+
 func endpointWriterProducer(remote *Remote, address string, config *Config) actor.Producer {
 	return func() actor.Actor {
 		return &endpointWriter{
@@ -22,63 +26,173 @@ func endpointWriterProducer(remote *Remote, address string, config *Config) acto
 	}
 }
 
+// clientEndpointWriterProducer writes to a client over the stream the client opened, it cannot reconnect
+func clientEndpointWriterProducer(remote *Remote, address string, config *Config, stream remoteStream) actor.Producer {
+	return func() actor.Actor {
+		return &endpointWriter{
+			address:      address,
+			config:       config,
+			remote:       remote,
+			clientStream: stream,
+		}
+	}
+}
+
+// remoteStream is the sending side of a stream, opened by this system or by a client
+type remoteStream interface {
+	Send(*RemoteMessage) error
+}
+
 type endpointWriter struct {
-	config  *Config
-	address string
-	conn    *grpc.ClientConn
-	stream  Remoting_ReceiveClient
-	remote  *Remote
+	config       *Config
+	address      string
+	conn         *grpc.ClientConn
+	stream       remoteStream
+	clientStream remoteStream // the stream a client opened to this system, if the address is a client
+	remote       *Remote
+	retries      int
+	buffer       []*remoteDeliver // outbound messages held back while reconnecting
 }
 
-type restartAfterConnectFailure struct {
-	err error
+// reconnectEndpoint is sent to the writer itself when the backoff delay of a reconnect attempt has passed
+type reconnectEndpoint struct{}
+
+// endpointStreamLost is sent to the writer itself when the receiving side of the stream fails
+type endpointStreamLost struct {
+	stream remoteStream
+	err    error
 }
 
 func (state *endpointWriter) initialize(ctx actor.Context) {
 	now := time.Now()
 
+	if state.clientStream != nil {
+		state.remote.Logger().Info("Started EndpointWriter for client", slog.String("address", state.address))
+		state.stream = state.clientStream
+		state.remote.metrics.endpointConnected(state.address, 1)
+		return
+	}
+
 	state.remote.Logger().Info("Started EndpointWriter. connecting", slog.String("address", state.address))
 
-	var err error
+	if err := state.initializeInternal(ctx.Self()); err != nil {
+		state.remote.Logger().Error("EndpointWriter failed to connect", slog.String("address", state.address), slog.Any("error", err))
+		state.scheduleReconnect(ctx)
+		return
+	}
 
-	for i := 0; i < state.remote.config.MaxRetryCount; i++ {
-		err = state.initializeInternal()
-		if err != nil {
-			state.remote.Logger().Error("EndpointWriter failed to connect", slog.String("address", state.address), slog.Any("error", err), slog.Int("retry", i))
-			// Wait 2 seconds to restart and retry
-			// Replace with Exponential Backoff
-			time.Sleep(2 * time.Second)
-			continue
-		}
+	state.remote.Logger().Info("EndpointWriter connected", slog.String("address", state.address), slog.Duration("cost", time.Since(now)))
+}
 
-		break
+// reconnect is a single reconnect attempt, on success the messages buffered in the meantime are sent
+func (state *endpointWriter) reconnect(ctx actor.Context) {
+	if state.stream != nil {
+		return
 	}
 
-	if err != nil {
-		terminated := &EndpointTerminatedEvent{
-			Address: state.address,
-		}
-		state.remote.actorSystem.EventStream.Publish(terminated)
+	state.closeClientConn()
+	state.remote.metrics.endpointReconnect(state.address)
+	if err := state.initializeInternal(ctx.Self()); err != nil {
+		state.remote.Logger().Error("EndpointWriter failed to reconnect", slog.String("address", state.address), slog.Any("error", err), slog.Int("retry", state.retries))
+		state.scheduleReconnect(ctx)
+		return
+	}
+
+	state.remote.Logger().Info("EndpointWriter reconnected", slog.String("address", state.address), slog.Int("retry", state.retries), slog.Int("buffered", len(state.buffer)))
+	state.retries = 0
+	buffered := state.buffer
+	state.buffer = nil
+	state.send(ctx, buffered)
+}
 
+// connectionLost drops the current stream, holds back the unsent messages and starts reconnecting
+func (state *endpointWriter) connectionLost(ctx actor.Context, err error, unsent []*remoteDeliver) {
+	if state.clientStream != nil {
+		// a client connects again by itself
+		state.remote.Logger().Warn("EndpointWriter lost connection to client", slog.String("address", state.address), slog.Any("error", err))
+		state.closeClientConn()
+		state.bufferMessages(unsent)
+		state.giveUp()
 		return
+	}
+
+	state.remote.Logger().Warn("EndpointWriter lost connection, reconnecting", slog.String("address", state.address), slog.Any("error", err))
+	state.closeClientConn()
+	state.bufferMessages(unsent)
+	state.scheduleReconnect(ctx)
+}
 
-		//	plog.Error("EndpointWriter failed to connect", log.String("address", state.address), log.Error(err))
+// scheduleReconnect schedules the next reconnect attempt with exponential backoff, or gives up once MaxRetryCount is reached
+func (state *endpointWriter) scheduleReconnect(ctx actor.Context) {
+	if state.retries >= state.config.MaxRetryCount {
+		state.giveUp()
+		return
+	}
 
-		// Wait 2 seconds to restart and retry
-		// TODO: Replace with Exponential Backoff
-		// send this as a message to self - do not block the mailbox processing
-		// if in the meantime the actor is stopped (EndpointTerminated event), the message will be ignored (deadlettered)
-		// TODO: would it be a better idea to just publish EndpointTerminatedEvent here? to use the same path as when the connection is lost?
-		//	time.AfterFunc(2*time.Second, func() {
-		//		ctx.Send(ctx.Self(), &restartAfterConnectFailure{err})
-		//	})
+	delay := reconnectBackoff(state.config.RetryBackoff, state.config.RetryMaxBackoff, state.retries)
+	state.retries++
 
+	system, self := state.remote.actorSystem, ctx.Self()
+	time.AfterFunc(delay, func() {
+		system.Root.Send(self, &reconnectEndpoint{})
+	})
+}
+
+// giveUp quarantines the address and terminates the endpoint, which notifies the watchers
+func (state *endpointWriter) giveUp() {
+	state.remote.Logger().Error("EndpointWriter giving up on endpoint", slog.String("address", state.address), slog.Int("retries", state.retries), slog.Int("buffered", len(state.buffer)))
+
+	if state.clientStream == nil {
+		state.remote.edpManager.quarantine(state.address)
 	}
+	state.deadLetter(state.buffer)
+	state.buffer = nil
 
-	state.remote.Logger().Info("EndpointWriter connected", slog.String("address", state.address), slog.Duration("cost", time.Since(now)))
+	terminated := &EndpointTerminatedEvent{
+		Address: state.address,
+	}
+	state.remote.actorSystem.EventStream.Publish(terminated)
 }
 
-func (state *endpointWriter) initializeInternal() error {
+func reconnectBackoff(initial, max time.Duration, retry int) time.Duration {
+	delay := initial
+	for i := 0; i < retry && delay < max; i++ {
+		delay *= 2
+	}
+	if max > 0 && delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// bufferMessages holds back messages while reconnecting, the overflow goes to dead letters.
+// Watch and Unwatch are always kept, a lost Watch would leave the watcher waiting for a Terminated forever.
+func (state *endpointWriter) bufferMessages(rds []*remoteDeliver) {
+	for _, rd := range rds {
+		switch rd.message.(type) {
+		case *actor.Watch, *actor.Unwatch:
+		default:
+			if len(state.buffer) >= state.config.ReconnectBufferSize {
+				state.deadLetter([]*remoteDeliver{rd})
+				continue
+			}
+		}
+		state.buffer = append(state.buffer, rd)
+	}
+}
+
+func (state *endpointWriter) deadLetter(rds []*remoteDeliver) {
+	for _, rd := range rds {
+		switch rd.message.(type) {
+		case *actor.Watch, *actor.Unwatch:
+			// the EndpointWatcher tells the watchers once the endpoint is terminated
+			continue
+		}
+		state.remote.actorSystem.EventStream.Publish(&actor.DeadLetterEvent{Message: rd.message, Sender: rd.sender, PID: rd.target})
+	}
+}
+
+func (state *endpointWriter) initializeInternal(self *actor.PID) error {
 	conn, err := grpc.Dial(state.address, state.config.DialOptions...)
 	if err != nil {
 		return err
@@ -90,18 +204,10 @@ func (state *endpointWriter) initializeInternal() error {
 		state.remote.Logger().Error("EndpointWriter failed to create receive stream", slog.String("address", state.address), slog.Any("error", err))
 		return err
 	}
-	state.stream = stream
 
 	err = stream.Send(&RemoteMessage{
 		MessageType: &RemoteMessage_ConnectRequest{
-			ConnectRequest: &ConnectRequest{
-				ConnectionType: &ConnectRequest_ServerConnection{
-					ServerConnection: &ServerConnection{
-						SystemId: state.remote.actorSystem.ID,
-						Address:  state.remote.actorSystem.Address(),
-					},
-				},
-			},
+			ConnectRequest: state.connectRequest(),
 		},
 	})
 	if err != nil {
@@ -126,19 +232,32 @@ func (state *endpointWriter) initializeInternal() error {
 	}
 
 	go func() {
+		// a client gets the messages of the remote over the same stream
+		chunks := newChunkAssembler(state.config.MaxMessageSize)
 		for {
-			_, err := stream.Recv()
+			msg, err := stream.Recv()
 			switch {
 			case errors.Is(err, io.EOF):
 				state.remote.Logger().Debug("EndpointWriter stream completed", slog.String("address", state.address))
 				return
 			case err != nil:
 				state.remote.Logger().Error("EndpointWriter lost connection", slog.String("address", state.address), slog.Any("error", err))
-				terminated := &EndpointTerminatedEvent{
-					Address: state.address,
-				}
-				state.remote.actorSystem.EventStream.Publish(terminated)
+				state.remote.actorSystem.Root.Send(self, &endpointStreamLost{stream: stream, err: err})
 				return
+			}
+
+			switch t := msg.MessageType.(type) {
+			case *RemoteMessage_MessageBatch:
+				state.receive(t.MessageBatch)
+			case *RemoteMessage_MessageChunk:
+				batch, err := chunks.add(t.MessageChunk)
+				if err != nil {
+					state.remote.Logger().Error("EndpointWriter dropped chunked message", slog.String("address", state.address), slog.Any("error", err))
+					continue
+				}
+				if batch != nil {
+					state.receive(batch)
+				}
 			default: // DisconnectRequest
 				state.remote.Logger().Info("EndpointWriter got DisconnectRequest form remote", slog.String("address", state.address))
 				terminated := &EndpointTerminatedEvent{
@@ -149,12 +268,96 @@ func (state *endpointWriter) initializeInternal() error {
 		}
 	}()
 
+	state.stream = stream
+	state.remote.metrics.endpointConnected(state.address, 1)
 	connected := &EndpointConnectedEvent{Address: state.address}
 	state.remote.actorSystem.EventStream.Publish(connected)
 	return nil
 }
 
-func (state *endpointWriter) sendEnvelopes(msg []interface{}, ctx actor.Context) {
+// connectRequest introduces this system to the remote, a client has no address the remote could connect to
+func (state *endpointWriter) connectRequest() *ConnectRequest {
+	if state.remote.client {
+		return &ConnectRequest{
+			ConnectionType: &ConnectRequest_ClientConnection{
+				ClientConnection: &ClientConnection{
+					SystemId: state.remote.actorSystem.ID,
+				},
+			},
+		}
+	}
+
+	return &ConnectRequest{
+		ConnectionType: &ConnectRequest_ServerConnection{
+			ServerConnection: &ServerConnection{
+				SystemId: state.remote.actorSystem.ID,
+				Address:  state.remote.actorSystem.Address(),
+			},
+		},
+	}
+}
+
+// receive delivers the messages a remote sent back to this client
+func (state *endpointWriter) receive(batch *MessageBatch) {
+	if !state.remote.client {
+		state.remote.Logger().Warn("EndpointWriter received messages but is not a client", slog.String("address", state.address))
+		return
+	}
+
+	if err := state.remote.edpReader.onMessageBatch(batch, state.address); err != nil {
+		state.remote.Logger().Error("EndpointWriter failed to deliver messages", slog.String("address", state.address), slog.Any("error", err))
+	}
+}
+
+// receiveBatch handles a batch of messages taken from the mailbox, in the order they were sent
+func (state *endpointWriter) receiveBatch(msg []interface{}, ctx actor.Context) {
+	pending := make([]*remoteDeliver, 0, len(msg))
+
+	for _, tmp := range msg {
+		switch m := tmp.(type) {
+		case *remoteDeliver:
+			pending = append(pending, m)
+		case *EndpointTerminatedEvent, EndpointTerminatedEvent:
+			state.remote.Logger().Debug("Handling array wrapped terminate event", slog.String("address", state.address), slog.Any("message", m))
+			state.deadLetter(pending)
+			ctx.Stop(ctx.Self())
+			return
+		case *reconnectEndpoint:
+			state.send(ctx, pending)
+			pending = pending[:0]
+			state.reconnect(ctx)
+		case *endpointStreamLost:
+			if m.stream != state.stream {
+				// a stream we already replaced
+				continue
+			}
+			state.send(ctx, pending)
+			pending = pending[:0]
+			state.connectionLost(ctx, m.err, nil)
+		}
+	}
+
+	state.send(ctx, pending)
+}
+
+// send sends the messages if connected and holds them back while reconnecting
+func (state *endpointWriter) send(ctx actor.Context, rds []*remoteDeliver) {
+	if len(rds) == 0 {
+		return
+	}
+
+	if state.stream == nil {
+		state.bufferMessages(rds)
+		return
+	}
+
+	if unsent, err := state.sendEnvelopes(rds); err != nil {
+		state.connectionLost(ctx, err, unsent)
+	}
+}
+
+// sendEnvelopes serializes and sends the messages, on a stream failure it returns the messages which were not sent
+func (state *endpointWriter) sendEnvelopes(rds []*remoteDeliver) ([]*remoteDeliver, error) {
 	envelopes := make([]*MessageEnvelope, 0)
 
 	// type name uniqueness map name string to type index
@@ -175,31 +378,27 @@ func (state *endpointWriter) sendEnvelopes(msg []interface{}, ctx actor.Context)
 		serializerID int32
 	)
 
-	for _, tmp := range msg {
-		switch unwrapped := tmp.(type) {
-		case *EndpointTerminatedEvent, EndpointTerminatedEvent:
-			state.remote.Logger().Debug("Handling array wrapped terminate event", slog.String("address", state.address), slog.Any("message", unwrapped))
-			ctx.Stop(ctx.Self())
-			return
-		}
-
-		rd, _ := tmp.(*remoteDeliver)
+	// index of the first message of the batch being built, and its size so far
+	batchStart, batchSize := 0, 0
+	flush := func() error {
+		err := state.sendBatch(envelopes, typeNamesArr, targetNamesArr, senderNamesArr)
+		envelopes = make([]*MessageEnvelope, 0)
+		typeNames, typeNamesArr = make(map[string]int32), make([]string, 0)
+		targetNames, targetNamesArr = make(map[string]int32), make([]*actor.PID, 0)
+		senderNames, senderNamesArr = make(map[string]int32), make([]*actor.PID, 0)
+		batchSize = 0
 
-		if state.stream == nil { // not connected yet since first connection attempt failed and we are waiting for the retry
-			if rd.sender != nil {
-				state.remote.actorSystem.Root.Send(rd.sender, &actor.DeadLetterResponse{Target: rd.target})
-			} else {
-				state.remote.actorSystem.EventStream.Publish(&actor.DeadLetterEvent{Message: rd.message, Sender: rd.sender, PID: rd.target})
-			}
-			continue
-		}
+		return err
+	}
 
+	for i, rd := range rds {
 		if rd.header == nil || rd.header.Length() == 0 {
 			header = nil
 		} else {
 			header = &MessageHeader{
 				HeaderData: rd.header.ToMap(),
 			}
+			deadlineToWire(header.HeaderData)
 		}
 
 		// if the message can be translated to a serialization representation, we do this here
@@ -207,18 +406,60 @@ func (state *endpointWriter) sendEnvelopes(msg []interface{}, ctx actor.Context)
 		message := rd.message
 		var err error
 		if v, ok := message.(RootSerializable); ok {
-			message, err = v.Serialize()
+			message, err = v.Serialize(state.remote.serializers)
 			if err != nil {
 				state.remote.Logger().Error("EndpointWriter failed to serialize message", slog.String("address", state.address), slog.Any("error", err), slog.Any("message", v))
 				continue
 			}
 		}
 
-		bytes, typeName, err := Serialize(message, serializerID)
+		serializerID = rd.serializerID
+		if serializerID < 0 {
+			serializerID, err = state.remote.serializers.SerializerIDFor(message)
+			if err != nil {
+				state.remote.metrics.serialized(state.address, fmt.Sprintf("%T", message), time.Time{}, err)
+				state.remote.Logger().Error("EndpointWriter failed to serialize message", slog.String("address", state.address), slog.Any("error", err), slog.Any("message", message))
+				continue
+			}
+		}
+
+		start := time.Now()
+		bytes, typeName, err := state.remote.serializers.SerializeWith(message, serializerID)
 		if err != nil {
+			state.remote.metrics.serialized(state.address, fmt.Sprintf("%T", message), start, err)
 			state.remote.Logger().Error("EndpointWriter failed to serialize message", slog.String("address", state.address), slog.Any("error", err), slog.Any("message", message))
 			continue
 		}
+		state.remote.metrics.serialized(state.address, typeName, start, nil)
+
+		if state.config.MaxMessageSize > 0 && len(bytes) > state.config.MaxMessageSize {
+			state.rejectTooLarge(rd, len(bytes), typeName)
+			continue
+		}
+
+		if state.config.MessageChunkSize > 0 && len(bytes) > state.config.MessageChunkSize {
+			// keep the ordering of messages, everything batched so far goes out before the chunks
+			if err := flush(); err != nil {
+				return rds[batchStart:], err
+			}
+
+			if err := state.sendChunked(rd, header, bytes, typeName, serializerID); err != nil {
+				return rds[i:], err
+			}
+			batchStart = i + 1
+			continue
+		}
+
+		// the batch goes out before it grows past what the receiving side accepts
+		size := len(bytes) + proto.Size(header) + len(typeName) + proto.Size(rd.target) + proto.Size(rd.sender)
+		if len(envelopes) > 0 && batchSize+size > state.maxBatchSize() {
+			if err := flush(); err != nil {
+				return rds[batchStart:], err
+			}
+			batchStart = i
+		}
+		batchSize += size
+
 		typeID, typeNamesArr = addToLookup(typeNames, typeName, typeNamesArr)
 		targetID, targetNamesArr = addToTargetLookup(targetNames, rd.target, targetNamesArr)
 		targetRequestID := rd.target.RequestId
@@ -241,25 +482,115 @@ func (state *endpointWriter) sendEnvelopes(msg []interface{}, ctx actor.Context)
 		})
 	}
 
+	if err := flush(); err != nil {
+		return rds[batchStart:], err
+	}
+
+	return nil, nil
+}
+
+// maxBatchSize is the size, in bytes, a MessageBatch is kept below: the chunk size, or the default receive limit
+// of gRPC when messages are not chunked. A single message larger than it is sent in a batch of its own.
+func (state *endpointWriter) maxBatchSize() int {
+	if state.config.MessageChunkSize > 0 {
+		return state.config.MessageChunkSize
+	}
+
+	return grpcDefaultMaxRecvMsgSize
+}
+
+// rejectTooLarge drops a message above the max message size and tells its sender
+func (state *endpointWriter) rejectTooLarge(rd *remoteDeliver, size int, typeName string) {
+	state.remote.Logger().Error("EndpointWriter message exceeds max message size", slog.String("address", state.address), slog.Int("size", size), slog.Int("maxSize", state.config.MaxMessageSize), slog.String("type", typeName))
+	if rd.sender != nil {
+		state.remote.actorSystem.Root.Send(rd.sender, &MessageTooLargeError{Target: rd.target, Size: size, MaxSize: state.config.MaxMessageSize})
+	}
+}
+
+// sendBatch sends the envelopes as a single MessageBatch
+func (state *endpointWriter) sendBatch(envelopes []*MessageEnvelope, typeNames []string, targets []*actor.PID, senders []*actor.PID) error {
 	if len(envelopes) == 0 {
-		return
+		return nil
 	}
 
-	err := state.stream.Send(&RemoteMessage{
+	msg := &RemoteMessage{
 		MessageType: &RemoteMessage_MessageBatch{
 			MessageBatch: &MessageBatch{
-				TypeNames: typeNamesArr,
-				Targets:   targetNamesArr,
-				Senders:   senderNamesArr,
+				TypeNames: typeNames,
+				Targets:   targets,
+				Senders:   senders,
 				Envelopes: envelopes,
 			},
 		},
-	})
+	}
+	err := state.stream.Send(msg)
 	if err != nil {
-		ctx.Stash()
 		state.remote.Logger().Debug("gRPC Failed to send", slog.String("address", state.address), slog.Any("error", err))
-		ctx.Stop(ctx.Self())
+		return err
+	}
+
+	if state.remote.metrics.enabled() {
+		state.remote.metrics.batchSent(state.address, len(envelopes), proto.Size(msg))
 	}
+	return nil
+}
+
+// sendChunked sends a single oversized message as a sequence of MessageChunk
+func (state *endpointWriter) sendChunked(rd *remoteDeliver, header *MessageHeader, bytes []byte, typeName string, serializerID int32) error {
+	var senders []*actor.PID
+	senderID, senderRequestID := int32(0), uint32(0)
+	if rd.sender != nil {
+		senders = []*actor.PID{stripRequestID(rd.sender)}
+		senderID, senderRequestID = 1, rd.sender.RequestId
+	}
+
+	batch := &MessageBatch{
+		TypeNames: []string{typeName},
+		Targets:   []*actor.PID{stripRequestID(rd.target)},
+		Senders:   senders,
+		Envelopes: []*MessageEnvelope{{
+			MessageHeader:   header,
+			MessageData:     bytes,
+			Sender:          senderID,
+			SerializerId:    serializerID,
+			TargetRequestId: rd.target.RequestId,
+			SenderRequestId: senderRequestID,
+		}},
+	}
+
+	if size := proto.Size(batch); state.config.MaxMessageSize > 0 && size > state.config.MaxMessageSize {
+		state.rejectTooLarge(rd, size, typeName)
+		return nil
+	}
+
+	chunks, err := splitMessageBatch(batch, state.config.MessageChunkSize)
+	if err != nil {
+		state.remote.Logger().Error("EndpointWriter failed to split message", slog.String("address", state.address), slog.Any("error", err), slog.String("type", typeName))
+		return nil
+	}
+
+	size := 0
+	for _, chunk := range chunks {
+		err = state.stream.Send(&RemoteMessage{
+			MessageType: &RemoteMessage_MessageChunk{
+				MessageChunk: chunk,
+			},
+		})
+		if err != nil {
+			state.remote.Logger().Debug("gRPC Failed to send", slog.String("address", state.address), slog.Any("error", err))
+			return err
+		}
+		size += len(chunk.Data)
+	}
+
+	state.remote.metrics.batchSent(state.address, 1, size)
+	return nil
+}
+
+func stripRequestID(pid *actor.PID) *actor.PID {
+	c, _ := proto.Clone(pid).(*actor.PID)
+	c.RequestId = 0
+	return c
 }
 
 func addToLookup(m map[string]int32, name string, a []string) (int32, []string) {
@@ -311,18 +642,18 @@ func (state *endpointWriter) Receive(ctx actor.Context) {
 		state.initialize(ctx)
 	case *actor.Stopped:
 		state.remote.Logger().Debug("EndpointWriter stopped", slog.String("address", state.address))
+		state.deadLetter(state.buffer)
+		state.buffer = nil
 		state.closeClientConn()
+		state.remote.metrics.untrackMailbox(state.address)
 	case *actor.Restarting:
 		state.remote.Logger().Debug("EndpointWriter restarting", slog.String("address", state.address))
 		state.closeClientConn()
 	case *EndpointTerminatedEvent:
 		state.remote.Logger().Info("EndpointWriter received EndpointTerminatedEvent, stopping", slog.String("address", state.address))
 		ctx.Stop(ctx.Self())
-	case *restartAfterConnectFailure:
-		state.remote.Logger().Debug("EndpointWriter initiating self-restart after failing to connect and a delay", slog.String("address", state.address))
-		panic(msg.err)
 	case []interface{}:
-		state.sendEnvelopes(msg, ctx)
+		state.receiveBatch(msg, ctx)
 	case actor.SystemMessage, actor.AutoReceiveMessage:
 		// ignore
 	default:
@@ -333,9 +664,13 @@ func (state *endpointWriter) Receive(ctx actor.Context) {
 func (state *endpointWriter) closeClientConn() {
 	state.remote.Logger().Info("EndpointWriter closing client connection", slog.String("address", state.address))
 	if state.stream != nil {
-		err := state.stream.CloseSend()
-		if err != nil {
-			state.remote.Logger().Error("EndpointWriter error when closing the stream", slog.Any("error", err))
+		state.remote.metrics.endpointConnected(state.address, -1)
+		// the stream of a client is closed by the endpoint reader once the client is gone
+		if stream, ok := state.stream.(Remoting_ReceiveClient); ok {
+			err := stream.CloseSend()
+			if err != nil {
+				state.remote.Logger().Error("EndpointWriter error when closing the stream", slog.Any("error", err))
+			}
 		}
 		state.stream = nil
 	}
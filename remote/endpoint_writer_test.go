@@ -0,0 +1,87 @@
+package remote
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, reconnectBackoff(100*time.Millisecond, time.Second, 0))
+	assert.Equal(t, 400*time.Millisecond, reconnectBackoff(100*time.Millisecond, time.Second, 2))
+	assert.Equal(t, time.Second, reconnectBackoff(100*time.Millisecond, time.Second, 10))
+}
+
+func TestEndpointWriter_BuffersUntilReconnected(t *testing.T) {
+	port := freePort(t)
+	address := fmt.Sprintf("127.0.0.1:%d", port)
+
+	system1 := actor.NewActorSystem()
+	remote1 := NewRemote(system1, Configure("localhost", 0, WithRetryBackoff(50*time.Millisecond, 200*time.Millisecond), WithMaxRetryCount(20)))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	// nothing is listening yet, the message is held back while the writer retries
+	future := system1.Root.RequestFuture(actor.NewPID(address, "echo"), &actor.PID{Id: "ping"}, 5*time.Second)
+
+	time.Sleep(200 * time.Millisecond)
+
+	system2 := actor.NewActorSystem()
+	_, err := system2.Root.SpawnNamed(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*actor.PID); ok {
+			ctx.Respond(msg)
+		}
+	}), "echo")
+	require.NoError(t, err)
+	remote2 := NewRemote(system2, Configure("127.0.0.1", port))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	res, err := future.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "ping", res.(*actor.PID).Id)
+}
+
+func TestEndpointWriter_GivesUpAndQuarantines(t *testing.T) {
+	address := fmt.Sprintf("127.0.0.1:%d", freePort(t))
+
+	system := actor.NewActorSystem()
+	remote := NewRemote(system, Configure("localhost", 0, WithRetryBackoff(10*time.Millisecond, 10*time.Millisecond), WithMaxRetryCount(2), WithQuarantineDuration(time.Minute)))
+	remote.Start()
+	defer remote.Shutdown(true)
+
+	terminated := make(chan *actor.Terminated, 1)
+	watchee := actor.NewPID(address, "gone")
+	system.Root.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *actor.Started:
+			ctx.Watch(watchee)
+		case *actor.Terminated:
+			terminated <- msg
+		}
+	}))
+
+	select {
+	case msg := <-terminated:
+		assert.Equal(t, actor.TerminatedReason_AddressTerminated, msg.Why)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher was not notified")
+	}
+
+	assert.True(t, remote.edpManager.isQuarantined(address))
+
+	_, err := system.Root.RequestFuture(watchee, &actor.PID{}, time.Second).Result()
+	assert.ErrorIs(t, err, actor.ErrDeadLetter)
+}
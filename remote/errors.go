@@ -1,5 +1,12 @@
 package remote
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
 var (
 	ErrUnAvailable             = &ResponseError{ResponseStatusCodeUNAVAILABLE}
 	ErrTimeout                 = &ResponseError{ResponseStatusCodeTIMEOUT}
@@ -8,6 +15,12 @@ var (
 	ErrUnknownError            = &ResponseError{ResponseStatusCodeERROR}
 )
 
+var (
+	ErrUnknownSerializer   = errors.New("unknown serializer id")
+	ErrNoSerializerForType = errors.New("no serializer bound for type")
+	ErrUnknownTypeName     = errors.New("unknown type name")
+)
+
 // ResponseError is an error type.
 // e.g.:
 //
@@ -23,3 +36,20 @@ func (r *ResponseError) Error() string {
 
 	return r.Code.String()
 }
+
+// MessageTooLargeError is sent back to the sender when a message exceeds the configured max message size.
+// A future sender completes with it as its error, an actor sender receives it as a message.
+type MessageTooLargeError struct {
+	Target  *actor.PID
+	Size    int
+	MaxSize int
+}
+
+func (e *MessageTooLargeError) Error() string {
+	return fmt.Sprintf("message to %v is %d bytes, exceeding the max message size of %d bytes", e.Target, e.Size, e.MaxSize)
+}
+
+var _ actor.FailureResponse = &MessageTooLargeError{}
+
+// FailureResponse marks the error as a failed response, see actor.FailureResponse
+func (e *MessageTooLargeError) FailureResponse() {}
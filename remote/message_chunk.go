@@ -0,0 +1,109 @@
+package remote
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var chunkSequence atomic.Uint64
+
+// splitMessageBatch serializes the batch and splits it into chunks of at most chunkSize bytes
+func splitMessageBatch(batch *MessageBatch, chunkSize int) ([]*MessageChunk, error) {
+	data, err := proto.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = len(data)
+	}
+
+	count := (len(data) + chunkSize - 1) / chunkSize
+	id := chunkSequence.Add(1)
+	chunks := make([]*MessageChunk, 0, count)
+
+	for i := 0; i < count; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		chunks = append(chunks, &MessageChunk{
+			Id:    id,
+			Index: int32(i),
+			Count: int32(count),
+			Data:  data[i*chunkSize : end],
+		})
+	}
+
+	return chunks, nil
+}
+
+// chunkAssembler reassembles the chunks received on a single stream.
+// Chunks of one batch are always sent back to back, so only one batch is in flight at a time.
+type chunkAssembler struct {
+	maxSize int
+	id      uint64
+	dropped uint64
+	next    int32
+	data    []byte
+}
+
+func newChunkAssembler(maxSize int) *chunkAssembler {
+	return &chunkAssembler{
+		maxSize: maxSize,
+	}
+}
+
+// add appends the chunk to the pending batch and returns the batch once all chunks have arrived
+func (a *chunkAssembler) add(chunk *MessageChunk) (*MessageBatch, error) {
+	if chunk.Id == a.dropped {
+		return nil, nil
+	}
+
+	if chunk.Index == 0 {
+		a.id = chunk.Id
+		a.next = 0
+		a.data = a.data[:0]
+	}
+
+	if chunk.Id != a.id || chunk.Index != a.next {
+		a.drop(chunk.Id)
+		return nil, fmt.Errorf("unexpected message chunk %d of batch %d", chunk.Index, chunk.Id)
+	}
+
+	if a.maxSize > 0 && len(a.data)+len(chunk.Data) > a.maxSize {
+		a.drop(chunk.Id)
+		return nil, fmt.Errorf("chunked message batch %d exceeds max message size %d", chunk.Id, a.maxSize)
+	}
+
+	a.data = append(a.data, chunk.Data...)
+	a.next++
+
+	if a.next < chunk.Count {
+		return nil, nil
+	}
+
+	batch := &MessageBatch{}
+	err := proto.Unmarshal(a.data, batch)
+	a.reset()
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (a *chunkAssembler) reset() {
+	a.id = 0
+	a.next = 0
+	a.data = nil
+}
+
+// drop discards the pending batch and ignores the remaining chunks of the given batch
+func (a *chunkAssembler) drop(id uint64) {
+	a.reset()
+	a.dropped = id
+}
@@ -0,0 +1,149 @@
+package remote
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func newChunkTestBatch(size int) *MessageBatch {
+	return &MessageBatch{
+		TypeNames: []string{"actor.PID"},
+		Targets:   []*actor.PID{actor.NewPID("localhost:0", "target")},
+		Envelopes: []*MessageEnvelope{{
+			MessageData: bytes.Repeat([]byte{42}, size),
+		}},
+	}
+}
+
+func TestSplitMessageBatch_Reassemble(t *testing.T) {
+	batch := newChunkTestBatch(10_000)
+
+	chunks, err := splitMessageBatch(batch, 1024)
+	assert.NoError(t, err)
+	assert.Greater(t, len(chunks), 9)
+
+	a := newChunkAssembler(0)
+	for i, chunk := range chunks {
+		res, err := a.add(chunk)
+		assert.NoError(t, err)
+		if i < len(chunks)-1 {
+			assert.Nil(t, res)
+		} else {
+			assert.Equal(t, batch.Envelopes[0].MessageData, res.Envelopes[0].MessageData)
+			assert.Equal(t, "target", res.Targets[0].Id)
+		}
+	}
+}
+
+func TestChunkAssembler_ExceedsMaxSize(t *testing.T) {
+	chunks, err := splitMessageBatch(newChunkTestBatch(10_000), 1024)
+	assert.NoError(t, err)
+
+	a := newChunkAssembler(4096)
+	failures := 0
+	for _, chunk := range chunks {
+		res, err := a.add(chunk)
+		assert.Nil(t, res)
+		if err != nil {
+			failures++
+		}
+	}
+	assert.Equal(t, 1, failures)
+
+	// the assembler accepts new batches after dropping one
+	next, err := splitMessageBatch(newChunkTestBatch(100), 1024)
+	assert.NoError(t, err)
+	res, err := a.add(next[0])
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+}
+
+func TestChunkAssembler_OutOfOrder(t *testing.T) {
+	chunks, err := splitMessageBatch(newChunkTestBatch(4096), 1024)
+	assert.NoError(t, err)
+
+	a := newChunkAssembler(0)
+	_, err = a.add(chunks[1])
+	assert.Error(t, err)
+}
+
+func TestRemote_SendsChunkedMessage(t *testing.T) {
+	echo := actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*MessageChunk); ok {
+			ctx.Respond(&MessageChunk{Id: uint64(len(msg.Data))})
+		}
+	})
+
+	system1 := actor.NewActorSystem()
+	remote1 := NewRemote(system1, Configure("localhost", 0, WithMessageChunkSize(64*1024), WithMaxMessageSize(2*1024*1024)))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	system2 := actor.NewActorSystem()
+	remote2 := NewRemote(system2, Configure("localhost", 0))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	pid, err := system2.Root.SpawnNamed(echo, "echo")
+	assert.NoError(t, err)
+
+	res, err := system1.Root.RequestFuture(pid, &MessageChunk{Data: bytes.Repeat([]byte{1}, 1024*1024)}, 5*time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1024*1024), res.(*MessageChunk).Id)
+
+	res, err = system1.Root.RequestFuture(pid, &MessageChunk{Data: bytes.Repeat([]byte{1}, 3*1024*1024)}, 5*time.Second).Result()
+	assert.Nil(t, res)
+	assert.IsType(t, &MessageTooLargeError{}, err)
+}
+
+func TestRemote_SplitsBatchesAboveChunkSize(t *testing.T) {
+	echo := actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*MessageChunk); ok {
+			ctx.Respond(&MessageChunk{Id: uint64(len(msg.Data))})
+		}
+	})
+
+	system1 := actor.NewActorSystem()
+	remote1 := NewRemote(system1, Configure("localhost", 0))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	system2 := actor.NewActorSystem()
+	remote2 := NewRemote(system2, Configure("localhost", 0))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	pid, err := system2.Root.SpawnNamed(echo, "echo")
+	assert.NoError(t, err)
+
+	// below the chunk size each, together above the receive limit of the server
+	var futures []*actor.Future
+	for i := 0; i < 6; i++ {
+		futures = append(futures, system1.Root.RequestFuture(pid, &MessageChunk{Data: bytes.Repeat([]byte{1}, 900*1024)}, 5*time.Second))
+	}
+	for _, future := range futures {
+		res, err := future.Result()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(900*1024), res.(*MessageChunk).Id)
+	}
+}
+
+func TestRemote_EnforcesMaxMessageSizeWithoutChunks(t *testing.T) {
+	system1 := actor.NewActorSystem()
+	remote1 := NewRemote(system1, Configure("localhost", 0, WithMessageChunkSize(0), WithMaxMessageSize(1024*1024)))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	system2 := actor.NewActorSystem()
+	remote2 := NewRemote(system2, Configure("localhost", 0))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	res, err := system1.Root.RequestFuture(actor.NewPID(system2.Address(), "echo"), &MessageChunk{Data: bytes.Repeat([]byte{1}, 2*1024*1024)}, 5*time.Second).Result()
+	assert.Nil(t, res)
+	assert.IsType(t, &MessageTooLargeError{}, err)
+}
@@ -0,0 +1,134 @@
+package remote
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// remoteMetrics records the remote instruments, all methods are no-ops when metrics are disabled
+type remoteMetrics struct {
+	instruments  *metrics.RemoteMetrics
+	address      func() string
+	mailboxes    sync.Map // remote address -> actor.Mailbox of its endpoint writer
+	registration metric.Registration
+}
+
+func newRemoteMetrics(r *Remote) *remoteMetrics {
+	m := &remoteMetrics{address: r.actorSystem.Address}
+
+	sysMetrics := actor.GetMetrics(r.actorSystem)
+	if sysMetrics == nil || !sysMetrics.Enabled() {
+		return m
+	}
+
+	pm := sysMetrics.ProtoMetrics()
+	pm.RegisterRemote(metrics.InternalRemoteMetrics, metrics.NewRemoteMetrics(r.Logger()))
+	m.instruments = pm.GetRemote(metrics.InternalRemoteMetrics)
+	if m.instruments == nil {
+		return m
+	}
+
+	meter := otel.Meter(metrics.LibName)
+	registration, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		m.mailboxes.Range(func(key, value interface{}) bool {
+			o.ObserveInt64(m.instruments.RemoteWriterMailboxLength, int64(value.(actor.Mailbox).UserMessageCount()),
+				metric.WithAttributes(m.labels(key.(string))...))
+			return true
+		})
+		return nil
+	}, m.instruments.RemoteWriterMailboxLength)
+	if err != nil {
+		r.Logger().Error("failed to instrument EndpointWriter mailboxes", slog.Any("error", err))
+	} else {
+		m.registration = registration
+	}
+
+	return m
+}
+
+func (m *remoteMetrics) enabled() bool {
+	return m.instruments != nil
+}
+
+func (m *remoteMetrics) labels(remoteAddress string, extra ...attribute.KeyValue) []attribute.KeyValue {
+	return append([]attribute.KeyValue{
+		attribute.String("address", m.address()),
+		attribute.String("remoteaddress", remoteAddress),
+	}, extra...)
+}
+
+func (m *remoteMetrics) trackMailbox(remoteAddress string, mb actor.Mailbox) {
+	if m.enabled() {
+		m.mailboxes.Store(remoteAddress, mb)
+	}
+}
+
+func (m *remoteMetrics) untrackMailbox(remoteAddress string) {
+	m.mailboxes.Delete(remoteAddress)
+}
+
+func (m *remoteMetrics) endpointConnected(remoteAddress string, delta int64) {
+	if m.enabled() {
+		m.instruments.RemoteEndpointConnectedCount.Add(context.Background(), delta, metric.WithAttributes(m.labels(remoteAddress)...))
+	}
+}
+
+func (m *remoteMetrics) endpointReconnect(remoteAddress string) {
+	if m.enabled() {
+		m.instruments.RemoteEndpointReconnectCount.Add(context.Background(), 1, metric.WithAttributes(m.labels(remoteAddress)...))
+	}
+}
+
+func (m *remoteMetrics) batchSent(remoteAddress string, messages int, bytes int) {
+	if m.enabled() {
+		attrs := metric.WithAttributes(m.labels(remoteAddress)...)
+		m.instruments.RemoteBatchSizeHistogram.Record(context.Background(), int64(messages), attrs)
+		m.instruments.RemoteBytesSentCount.Add(context.Background(), int64(bytes), attrs)
+	}
+}
+
+func (m *remoteMetrics) bytesReceived(remoteAddress string, bytes int) {
+	if m.enabled() {
+		m.instruments.RemoteBytesReceivedCount.Add(context.Background(), int64(bytes), metric.WithAttributes(m.labels(remoteAddress)...))
+	}
+}
+
+func (m *remoteMetrics) serialized(remoteAddress string, messageType string, start time.Time, err error) {
+	if !m.enabled() {
+		return
+	}
+
+	attrs := metric.WithAttributes(m.labels(remoteAddress, attribute.String("messagetype", messageType))...)
+	if err != nil {
+		m.instruments.RemoteSerializationErrorCount.Add(context.Background(), 1, attrs)
+		return
+	}
+	m.instruments.RemoteSerializationHistogram.Record(context.Background(), time.Since(start).Seconds(), attrs)
+}
+
+func (m *remoteMetrics) deserialized(remoteAddress string, messageType string, start time.Time, err error) {
+	if !m.enabled() {
+		return
+	}
+
+	attrs := metric.WithAttributes(m.labels(remoteAddress, attribute.String("messagetype", messageType))...)
+	if err != nil {
+		m.instruments.RemoteDeserializationErrorCount.Add(context.Background(), 1, attrs)
+		return
+	}
+	m.instruments.RemoteDeserializationHistogram.Record(context.Background(), time.Since(start).Seconds(), attrs)
+}
+
+func (m *remoteMetrics) stop() {
+	if m.registration != nil {
+		_ = m.registration.Unregister()
+	}
+}
@@ -0,0 +1,58 @@
+package remote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestRemoteMetrics_RecordsTraffic(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	otel.SetMeterProvider(provider)
+
+	system1 := actor.NewActorSystem(actor.WithMetricProviders(provider))
+	remote1 := NewRemote(system1, Configure("localhost", 0))
+	remote1.Start()
+	defer remote1.Shutdown(true)
+
+	system2 := actor.NewActorSystem()
+	remote2 := NewRemote(system2, Configure("localhost", 0))
+	remote2.Start()
+	defer remote2.Shutdown(true)
+
+	pid, err := system2.Root.SpawnNamed(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*actor.PID); ok {
+			ctx.Respond(msg)
+		}
+	}), "echo")
+	require.NoError(t, err)
+
+	_, err = system1.Root.RequestFuture(pid, &actor.PID{Id: "ping"}, 5*time.Second).Result()
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	names := map[string]bool{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names[m.Name] = true
+		}
+	}
+
+	assert.True(t, names["protoactor_remote_endpoint_connected_count"])
+	assert.True(t, names["protoactor_remote_batch_size"])
+	assert.True(t, names["protoactor_remote_bytes_sent_count"])
+	assert.True(t, names["protoactor_remote_bytes_received_count"])
+	assert.True(t, names["protoactor_remote_serialization_duration_seconds"])
+	assert.True(t, names["protoactor_remote_deserialization_duration_seconds"])
+	assert.True(t, names["protoactor_remote_writer_mailbox_length"])
+}
@@ -1,17 +1,27 @@
 package remote
 
+// built-in serializer ids
+const (
+	ProtoSerializerID int32 = iota
+	JsonSerializerID
+	CborSerializerID
+	BytesSerializerID
+)
+
 var (
 	DefaultSerializerID int32
-	serializers         []Serializer
+	defaultSerializers  = NewSerializerRegistry()
 )
 
-func init() {
-	RegisterSerializer(newProtoSerializer())
-	RegisterSerializer(newJsonSerializer())
+// RegisterSerializer registers a serializer with the process wide default registry under the next free id.
+// Registries created afterwards, including the ones of new Remote instances, inherit it.
+func RegisterSerializer(serializer Serializer) {
+	defaultSerializers.Register(defaultSerializers.nextID(), serializer)
 }
 
-func RegisterSerializer(serializer Serializer) {
-	serializers = append(serializers, serializer)
+// DefaultSerializers returns the process wide registry new Remote instances are created from
+func DefaultSerializers() *SerializerRegistry {
+	return defaultSerializers
 }
 
 type Serializer interface {
@@ -20,32 +30,45 @@ type Serializer interface {
 	GetTypeName(msg interface{}) (string, error)
 }
 
+// TypedSerializer is a Serializer which needs to know the concrete Go types it deserializes into
+type TypedSerializer interface {
+	Serializer
+	// RegisterType makes the type of msg known to the serializer under the given type names.
+	// The first name is written on the wire, the others are accepted as aliases when reading,
+	// which allows renaming types without breaking older senders.
+	RegisterType(msg interface{}, names ...string)
+}
+
+// CloneableSerializer is a Serializer with state, such as registered types, which must not be shared
+// between the clones of a SerializerRegistry. Serializers which are not cloneable are shared by the clones,
+// they must be stateless or safe to share.
+type CloneableSerializer interface {
+	Serializer
+	CloneSerializer() Serializer
+}
+
+// Serialize serializes the message using the process wide default registry
 func Serialize(message interface{}, serializerID int32) ([]byte, string, error) {
-	res, err := serializers[serializerID].Serialize(message)
-	if err != nil {
-		return nil, "", err
-	}
-	typeName, err := serializers[serializerID].GetTypeName(message)
-	if err != nil {
-		return nil, "", err
-	}
-	return res, typeName, nil
+	return defaultSerializers.SerializeWith(message, serializerID)
 }
 
+// Deserialize deserializes the message using the process wide default registry
 func Deserialize(message []byte, typeName string, serializerID int32) (interface{}, error) {
-	return serializers[serializerID].Deserialize(typeName, message)
+	return defaultSerializers.Deserialize(message, typeName, serializerID)
 }
 
 // RootSerializable is the root level in-process representation of a message
 type RootSerializable interface {
-	// Serialize returns the on-the-wire representation of the message
+	// Serialize returns the on-the-wire representation of the message, nested messages are serialized with the serializers
+	// of the Remote sending it
 	//   Message -> IRootSerialized -> ByteString
-	Serialize() (RootSerialized, error)
+	Serialize(serializers *SerializerRegistry) (RootSerialized, error)
 }
 
 // RootSerialized is the root level on-the-wire representation of a message
 type RootSerialized interface {
-	// Deserialize returns the in-process representation of a message
+	// Deserialize returns the in-process representation of a message, nested messages are deserialized with the serializers
+	// of the Remote receiving it
 	//   ByteString -> IRootSerialized -> Message
-	Deserialize() (RootSerializable, error)
+	Deserialize(serializers *SerializerRegistry) (RootSerializable, error)
 }
@@ -0,0 +1,165 @@
+package remote
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// SerializerRegistry holds the serializers known to a Remote and the serializer bound to each message type.
+// Messages of a bound type are always serialized with that serializer, unbound proto messages use
+// DefaultSerializerID and unbound []byte messages use the raw bytes serializer.
+type SerializerRegistry struct {
+	mu          sync.RWMutex
+	serializers map[int32]Serializer
+	types       map[reflect.Type]int32
+}
+
+// NewSerializerRegistry creates a registry with the built-in proto, JSON, CBOR and raw bytes serializers
+func NewSerializerRegistry() *SerializerRegistry {
+	r := &SerializerRegistry{
+		serializers: make(map[int32]Serializer),
+		types:       make(map[reflect.Type]int32),
+	}
+	r.Register(ProtoSerializerID, newProtoSerializer())
+	r.Register(JsonSerializerID, newJsonSerializer())
+	r.Register(CborSerializerID, NewCborSerializer())
+	r.Register(BytesSerializerID, newBytesSerializer())
+
+	return r
+}
+
+// Clone returns an independent copy of the registry, the CloneableSerializer instances are cloned
+// and the other serializers are shared with the copy
+func (r *SerializerRegistry) Clone() *SerializerRegistry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c := &SerializerRegistry{
+		serializers: make(map[int32]Serializer, len(r.serializers)),
+		types:       make(map[reflect.Type]int32, len(r.types)),
+	}
+	for id, s := range r.serializers {
+		if cs, ok := s.(CloneableSerializer); ok {
+			s = cs.CloneSerializer()
+		}
+		c.serializers[id] = s
+	}
+	for t, id := range r.types {
+		c.types[t] = id
+	}
+
+	return c
+}
+
+// Register adds or replaces the serializer with the given id
+func (r *SerializerRegistry) Register(id int32, serializer Serializer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.serializers[id] = serializer
+}
+
+// RegisterType binds the type of msg to the serializer with the given id.
+// If the serializer is a TypedSerializer, the type is registered with it under the given names.
+func (r *SerializerRegistry) RegisterType(msg interface{}, serializerID int32, names ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.serializers[serializerID]
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownSerializer, serializerID)
+	}
+
+	if ts, ok := s.(TypedSerializer); ok {
+		ts.RegisterType(msg, names...)
+	}
+	r.types[reflect.TypeOf(msg)] = serializerID
+
+	return nil
+}
+
+// Get returns the serializer with the given id
+func (r *SerializerRegistry) Get(id int32) (Serializer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.serializers[id]
+	return s, ok
+}
+
+// SerializerIDFor returns the id of the serializer used for the message when none is given explicitly
+func (r *SerializerRegistry) SerializerIDFor(msg interface{}) (int32, error) {
+	r.mu.RLock()
+	id, ok := r.types[reflect.TypeOf(msg)]
+	r.mu.RUnlock()
+
+	if ok {
+		return id, nil
+	}
+
+	switch msg.(type) {
+	case *JsonMessage:
+		return JsonSerializerID, nil
+	case proto.Message:
+		return DefaultSerializerID, nil
+	case []byte:
+		return BytesSerializerID, nil
+	}
+
+	return 0, fmt.Errorf("%w: %T", ErrNoSerializerForType, msg)
+}
+
+// Serialize serializes the message with the serializer bound to its type
+func (r *SerializerRegistry) Serialize(msg interface{}) ([]byte, string, int32, error) {
+	id, err := r.SerializerIDFor(msg)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	bytes, typeName, err := r.SerializeWith(msg, id)
+	return bytes, typeName, id, err
+}
+
+// SerializeWith serializes the message with the serializer with the given id
+func (r *SerializerRegistry) SerializeWith(msg interface{}, serializerID int32) ([]byte, string, error) {
+	s, ok := r.Get(serializerID)
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %d", ErrUnknownSerializer, serializerID)
+	}
+
+	res, err := s.Serialize(msg)
+	if err != nil {
+		return nil, "", err
+	}
+	typeName, err := s.GetTypeName(msg)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, typeName, nil
+}
+
+// Deserialize deserializes the bytes with the serializer with the given id
+func (r *SerializerRegistry) Deserialize(bytes []byte, typeName string, serializerID int32) (interface{}, error) {
+	s, ok := r.Get(serializerID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownSerializer, serializerID)
+	}
+
+	return s.Deserialize(typeName, bytes)
+}
+
+func (r *SerializerRegistry) nextID() int32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id := int32(0)
+	for k := range r.serializers {
+		if k >= id {
+			id = k + 1
+		}
+	}
+	return id
+}
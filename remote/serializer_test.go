@@ -55,3 +55,96 @@ func TestProtobufSerializer_Serialize_PID(t *testing.T) {
 	assert.Equal(t, "actor.PID", typeName)
 	assert.True(t, m.Equal(typed))
 }
+
+type cborTestMessage struct {
+	Name  string
+	Count int
+}
+
+type cborTestMessageV2 struct {
+	Name    string
+	Count   int
+	Comment string
+}
+
+func TestSerializerRegistry_PicksSerializerByType(t *testing.T) {
+	r := NewSerializerRegistry()
+	assert.NoError(t, r.RegisterType(&cborTestMessage{}, CborSerializerID))
+
+	_, _, id, err := r.Serialize(&actor.PID{Id: "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, ProtoSerializerID, id)
+
+	_, _, id, err = r.Serialize([]byte("raw"))
+	assert.NoError(t, err)
+	assert.Equal(t, BytesSerializerID, id)
+
+	b, typeName, id, err := r.Serialize(&cborTestMessage{Name: "foo", Count: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, CborSerializerID, id)
+
+	res, err := r.Deserialize(b, typeName, id)
+	assert.NoError(t, err)
+	assert.Equal(t, &cborTestMessage{Name: "foo", Count: 3}, res)
+
+	_, _, _, err = r.Serialize(cborTestMessage{})
+	assert.ErrorIs(t, err, ErrNoSerializerForType)
+}
+
+func TestSerializerRegistry_UnknownTypeName(t *testing.T) {
+	r := NewSerializerRegistry()
+
+	_, err := r.Deserialize(nil, "does.not.Exist", ProtoSerializerID)
+	assert.ErrorIs(t, err, ErrUnknownTypeName)
+
+	_, err = r.Deserialize(nil, "does.not.Exist", CborSerializerID)
+	assert.ErrorIs(t, err, ErrUnknownTypeName)
+
+	_, err = r.Deserialize(nil, "actor.PID", 42)
+	assert.ErrorIs(t, err, ErrUnknownSerializer)
+}
+
+func TestSerializerRegistry_SchemaEvolution(t *testing.T) {
+	sender := NewSerializerRegistry()
+	assert.NoError(t, sender.RegisterType(&cborTestMessage{}, CborSerializerID, "test.Message"))
+
+	receiver := NewSerializerRegistry()
+	assert.NoError(t, receiver.RegisterType(&cborTestMessageV2{}, CborSerializerID, "test.MessageV2", "test.Message"))
+
+	b, typeName, id, err := sender.Serialize(&cborTestMessage{Name: "foo", Count: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, "test.Message", typeName)
+
+	res, err := receiver.Deserialize(b, typeName, id)
+	assert.NoError(t, err)
+	assert.Equal(t, &cborTestMessageV2{Name: "foo", Count: 3}, res)
+}
+
+func TestSerializerRegistry_IsPerRemote(t *testing.T) {
+	remote1 := NewRemote(actor.NewActorSystem(), Configure("localhost", 0))
+	remote2 := NewRemote(actor.NewActorSystem(), Configure("localhost", 0))
+
+	assert.NoError(t, remote1.Serializers().RegisterType(&cborTestMessage{}, CborSerializerID))
+
+	_, err := remote1.Serializers().SerializerIDFor(&cborTestMessage{})
+	assert.NoError(t, err)
+	_, err = remote2.Serializers().SerializerIDFor(&cborTestMessage{})
+	assert.ErrorIs(t, err, ErrNoSerializerForType)
+}
+
+func TestSerializerRegistry_CloneCopiesCloneableSerializers(t *testing.T) {
+	const customSerializerID = 100
+
+	r := NewSerializerRegistry()
+	r.Register(customSerializerID, NewCborSerializer())
+	c := r.Clone()
+	assert.NoError(t, c.RegisterType(&cborTestMessage{}, customSerializerID))
+
+	s, _ := r.Get(customSerializerID)
+	_, err := s.GetTypeName(&cborTestMessage{})
+	assert.ErrorIs(t, err, ErrNoSerializerForType)
+
+	s, _ = c.Get(customSerializerID)
+	_, err = s.GetTypeName(&cborTestMessage{})
+	assert.NoError(t, err)
+}
@@ -25,6 +25,9 @@ type Remote struct {
 	kinds        map[string]*actor.Props
 	activatorPid *actor.PID
 	blocklist    *BlockList
+	serializers  *SerializerRegistry
+	metrics      *remoteMetrics
+	client       bool // started without a server, see StartClient
 }
 
 func NewRemote(actorSystem *actor.ActorSystem, config *Config) *Remote {
@@ -33,10 +36,15 @@ func NewRemote(actorSystem *actor.ActorSystem, config *Config) *Remote {
 		config:      config,
 		kinds:       make(map[string]*actor.Props),
 		blocklist:   NewBlockList(),
+		serializers: config.Serializers,
+	}
+	if r.serializers == nil {
+		r.serializers = DefaultSerializers().Clone()
 	}
 	for k, v := range config.Kinds {
 		r.kinds[k] = v
 	}
+	r.metrics = newRemoteMetrics(r)
 
 	actorSystem.Extensions.Register(r)
 
@@ -56,6 +64,9 @@ func (r *Remote) ExtensionID() extensions.ExtensionID {
 
 func (r *Remote) BlockList() *BlockList { return r.blocklist }
 
+// Serializers returns the serializer registry used by this remote
+func (r *Remote) Serializers() *SerializerRegistry { return r.serializers }
+
 // Start the remote server
 func (r *Remote) Start() {
 	grpclog.SetLoggerV2(grpclog.NewLoggerV2(ioutil.Discard, ioutil.Discard, ioutil.Discard))
@@ -86,6 +97,15 @@ func (r *Remote) Start() {
 }
 
 func (r *Remote) Shutdown(graceful bool) {
+	defer r.metrics.stop()
+
+	if r.client {
+		r.edpReader.suspend(true)
+		r.edpManager.stop()
+		r.Logger().Info("Stopped Proto.Actor client")
+		return
+	}
+
 	if graceful {
 		// TODO: need more graceful
 		r.edpReader.suspend(true)
@@ -113,6 +133,7 @@ func (r *Remote) Shutdown(graceful bool) {
 	}
 }
 
+// SendMessage sends the message to the remote pid, a negative serializerID picks the serializer bound to the message type
 func (r *Remote) SendMessage(pid *actor.PID, header actor.ReadonlyMessageHeader, message interface{}, sender *actor.PID, serializerID int32) {
 	rd := &remoteDeliver{
 		header:       header,